@@ -8,100 +8,77 @@ import (
 )
 
 //AStarAnalyseFile uses the A* Graphing Algorythm to find the shorted path between two words of the same length when changing one letter at a time.
-//It will read in the list of words to be used
+//It will read in the list of words to be used, then search them as sequences of letters (see AStarAnalyseSequences for other kinds of sequence).
 //INPUTS: startword, endword, filelocation, delimiter (strings) (**If delimiter is not to be used enter ""**)
 //OUTPUT: path found result (Boolean), path from end word to start word ([]string) (if no path is found emtpy array is returned)
 func AStarAnalyseFile(sW, eW, fL, dL string) (foundResult bool, resultPath []string) {
 	//List of all words that can possibly be used.
 	wordDictionary := readFile(sW, eW, fL, dL)
-	//List of words that have been assigned a partentNode and are still to be analyzed
-	openList := make([]*aStarWordNode, 0)
-	//List of words that have been analyzed.
-	closedList := make([]*aStarWordNode, 0)
-	//The aStarWordNode that relates to the start word selected.
-	startNode := newAStarWordNode(sW)
-	//The aStarWordNode that relates to the end word seletected.
+
+	return aStarAnalyse(sW, eW, wordDictionary)
+}
+
+//Use the A* Graphing Algorythm to find the shortest path between the start and end word through the word nodes in the dictionary.
+//The words are searched as sequences of bytes by AStarAnalyseSequences, the dictionary must not contain the start or end word and cannot be reused.
+func aStarAnalyse(sW, eW string, wordDictionary []*aStarWordNode) (foundResult bool, resultPath []string) {
 	endNode := newAStarWordNode(eW)
-	//List used to store the children nodes that relate to the current node being checked.
-	childenNodes := make([]*aStarWordNode, 0)
-	//Boolean used to indicate if a path has been found.
-	foundResult = false
-
-	//Calculate the estimated minimum cost from start to end word.
-	startNode.HScore = calculateNodeCost(startNode.Word, endNode.Word)
-	startNode.FScore = startNode.HScore
-	//Add startWord to openList
-	openList = append(openList, &startNode)
-	//Add endword to the list of words to be analyzed.
-	wordDictionary = append(wordDictionary, &endNode)
-
-	//While there are still elements in openList continue analysis
-	for len(openList) != 0 {
-		//The current node being analyzed.
-		var currentNode *aStarWordNode
-		//int used to indicate the best potential score of all nodes in the open list
-		bestFScore := -1
-		//int used to indicate the best potential score of all nodes in the open list
-		bestGScore := -1
-		//Int used to indicate the position of the best score.
-		index := 0
-
-		//Find the best scored node in current openList
-		for i, node := range openList {
-			//Special case if the bestFScore is -1 then this is first pass through analysis.
-			if bestFScore >= node.FScore || bestFScore == -1 {
-				//Special case if the bestGScore is -1 then this is first pass through analysis.
-				//We check for the lowest GScore after the best Fscore to make sure that children nodes are attached at the earliest point possible.
-				if bestGScore >= node.GScore || bestGScore == -1 {
-					//Store details of the best scored node in open list.
-					bestFScore = node.FScore
-					bestGScore = node.GScore
-					currentNode = node
-					index = i
-				}
-			}
-		}
+	source := &wordList{words: append(wordDictionary, &endNode), pool: newScanPool(len(wordDictionary) + 1)}
+	defer source.pool.close()
 
-		//remove current node from openList
-		openList = append(openList[:index], openList[index+1:]...)
+	foundResult, sequencePath := aStarAnalyseSequences([]byte(sW), []byte(eW), source)
 
-		//If true we have found the solution
-		if currentNode.Word == endNode.Word {
-			foundResult = true
-			break
-		}
+	return foundResult, sequencePathWords(sequencePath)
+}
 
-		//Get all the word nodes that are 1 step from the current node, update word dictionary so that all words found are removed from list to be analyzed.
-		childenNodes, wordDictionary = generateNodeChildren(currentNode, wordDictionary)
-		//G score (cost of path to this point) will always be current gscore + 1 for children as they are 1 step from the previous node.
-		tempGScore := currentNode.GScore + 1
-
-		//For each child node update the scores and add the node to the open list
-		for _, cN := range childenNodes {
-			if tempGScore < cN.GScore || cN.GScore == 0 {
-				cN.GScore = tempGScore
-				cN.HScore = calculateNodeCost(cN.Word, endNode.Word)
-				cN.FScore = cN.GScore + cN.HScore
-				cN.ParentNode = currentNode
-				openList = append(openList, cN)
-			}
-		}
+//wordList is the sequenceSource of a list of word nodes, the nodes are scanned by generateNodeChildren so packed words are compared all at once.
+type wordList struct {
+	//Word nodes that have not been found yet.
+	words []*aStarWordNode
+	//Goroutines used to scan the words (nil if they are too few to split).
+	pool *scanPool
+}
 
-		//Append current node to closed list as it has now been analysed
-		closedList = append(closedList, currentNode)
-	}
+//Find the words in the list 1 letter different from a node and remove them from the list, a search node is created for each word found.
+func (l *wordList) children(node *sequenceNode[byte]) []*sequenceNode[byte] {
+	//The node's word is packed once so it can be compared with every packed word in the list.
+	wordNode := newAStarWordNode(string(node.Sequence))
+	var childWords []*aStarWordNode
+	childWords, l.words = l.pool.nodeChildren(&wordNode, l.words, nil)
 
-	if foundResult {
-		resultPath = getResultPath(endNode)
-	} else {
-		resultPath = []string{}
+	childrenNodes := make([]*sequenceNode[byte], len(childWords))
+	for i, childWord := range childWords {
+		childrenNodes[i] = &sequenceNode[byte]{Sequence: []byte(childWord.Word)}
 	}
+	return childrenNodes
+}
 
-	return
+//Convert a path of byte sequences into a path of words.
+func sequencePathWords(sequencePath [][]byte) []string {
+	words := make([]string, len(sequencePath))
+	for i, sequence := range sequencePath {
+		words[i] = string(sequence)
+	}
+	return words
 }
 
 //Function to read in the word file and create a list of wordNodes from the data.
 func readFile(startWord, endWord, fileLocation, delimiter string) []*aStarWordNode {
+	//Array to store wordNodes.
+	wD := make([]*aStarWordNode, 0)
+
+	for _, word := range readWordList(fileLocation, delimiter) {
+		//Check the word is not the start or end word (these are dealt with seperately) and if not then create word node and add it to the array.
+		if word != startWord && word != endWord {
+			aStarWordNode := newAStarWordNode(word)
+			wD = append(wD, &aStarWordNode)
+		}
+	}
+
+	return wD
+}
+
+//Function to read in the word file and return every word in it (in file order).
+func readWordList(fileLocation, delimiter string) []string {
 	//Open the file and log an error if there is one.
 	file, err := os.Open(fileLocation)
 	if err != nil {
@@ -110,35 +87,26 @@ func readFile(startWord, endWord, fileLocation, delimiter string) []*aStarWordNo
 	//Defer file.close to the end of this function.
 	defer file.Close()
 
-	//Array to store wordNodes.
-	wD := make([]*aStarWordNode, 0)
+	//Array to store the words.
+	words := make([]string, 0)
 
 	//create scanner for the file opened.
 	scanner := bufio.NewScanner(file)
-	//While there are still lines in the file:
+	//While there are still lines in the file add the line (or each delimited word in the line) to the array.
 	for scanner.Scan() {
-		//Check the text is not the start or end word (these are dealt with seperately) and if not then create word node and add it to the array.
 		if delimiter == "" {
-			if scanner.Text() != startWord && scanner.Text() != endWord {
-				aStarWordNode := newAStarWordNode(scanner.Text())
-				wD = append(wD, &aStarWordNode)
-			}
+			words = append(words, scanner.Text())
 		} else {
-			words := strings.Split(scanner.Text(), delimiter)
-			for _, word := range words {
-				if word != startWord && word != endWord {
-					aStarWordNode := newAStarWordNode(word)
-					wD = append(wD, &aStarWordNode)
-				}
-			}
+			words = append(words, strings.Split(scanner.Text(), delimiter)...)
 		}
-
 	}
 
-	return wD
+	return words
 }
 
 //Calculate the minimum potential cost from one word to another.
+//The words are compared a letter at a time, packing both words for a single comparison costs more than it saves (see BenchmarkCalculateNodeCost),
+//so packed comparisons are only used for search nodes which are packed once and compared many times.
 func calculateNodeCost(s, e string) int {
 	//The maximum result will be if every letter is different in the two words (steps would be length).
 	result := len(s)
@@ -155,8 +123,17 @@ func calculateNodeCost(s, e string) int {
 	return result
 }
 
+//Calculate the minimum potential number of moves from one word to another when up to k letters can change in each move.
+func calculateMultiLetterNodeCost(s, e string, k int) int {
+	//Round up as a move that changes fewer than k letters is still a move.
+	return (calculateNodeCost(s, e) + k - 1) / k
+}
+
 //Generate all the children nodes when given a starting node and a list of potential nodes.
-func generateNodeChildren(node *aStarWordNode, dict []*aStarWordNode) (childrenNodes, newDict []*aStarWordNode) {
+//Words the move rules exclude are skipped, they are neither children nor kept in the new dictionary.
+//Words close enough to be a child whose move the rules do not allow are kept in the new dictionary (**If every 1 letter change is allowed enter nil**)
+//The dictionary is scanned on the calling goroutine, see scanPool.nodeChildren to split the scan.
+func generateNodeChildren(node *aStarWordNode, dict []*aStarWordNode, moves *moveRules) (childrenNodes, newDict []*aStarWordNode) {
 	//The array to store the children nodes (maximum potential size / cap is length of aStarWordNode dictionary)
 	childrenNodes = make([]*aStarWordNode, 0, len(dict))
 
@@ -165,16 +142,36 @@ func generateNodeChildren(node *aStarWordNode, dict []*aStarWordNode) (childrenN
 	wordLength := len(node.Word) - 1
 	//Number of letters that are the same from current node and the potential children.
 	matchingLetters := 0
+	//Fewest letters that can match for a word to be a child.
+	minMatching := wordLength + 1 - moves.maxLetters()
 
 	//For each potential word calculate the number of matching letters, update the node and lists as required based on matching letters.
 	for _, dictNode := range dict {
-		for i := 0; i <= wordLength; i++ {
-			if node.Word[i] == dictNode.Word[i] {
-				matchingLetters++
+		if moves.isExcluded(dictNode.Word) {
+			continue
+		}
+		//Words of a different length can never be a child so are kept in the dictionary.
+		if len(dictNode.Word) != len(node.Word) {
+			newDict = append(newDict, dictNode)
+			continue
+		}
+		//Words that are both packed are compared all at once, otherwise each letter is compared.
+		if node.isPacked && dictNode.isPacked {
+			matchingLetters = wordLength + 1 - packedDifferences(node.packed, dictNode.packed)
+		} else {
+			for i := 0; i <= wordLength; i++ {
+				if node.Word[i] == dictNode.Word[i] {
+					matchingLetters++
+				}
 			}
 		}
-		//This means there is only 1 letter different (matching are length-1) so is will be a child of the current node.
-		if matchingLetters == wordLength {
+		//This means there is only 1 letter different (matching are length-1) or up to the most letters a move can change,
+		//so is will be a child of the current node if the move is allowed. Two letters different can also be a swap of letters next to each other.
+		isChild := matchingLetters >= minMatching && matchingLetters <= wordLength
+		if !isChild && moves != nil && moves.swaps && matchingLetters == wordLength-1 {
+			isChild = isAdjacentSwap(node.Word, dictNode.Word)
+		}
+		if isChild && moves.allows(node, dictNode.Word) {
 			//Add the node to the childrenNode list.
 			childrenNodes = append(childrenNodes, dictNode)
 		} else {
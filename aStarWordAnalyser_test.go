@@ -197,6 +197,48 @@ func TestCalculateNodeCost(t *testing.T) {
 	fmt.Print("\n")
 }
 
+//Test the multi letter node cost is the fewest moves needed when up to k letters change in each move.
+func TestCalculateMultiLetterNodeCost(t *testing.T) {
+	fmt.Println("Testing multi letter node cost calculation method: 'calculateMultiLetterNodeCost'....")
+
+	//Arrange
+	testInputs := []aStarCalculateMultiLetterNodeCostMockInput{
+		{StartWord: "test", EndWord: "test", K: 2, Result: 0},
+		{StartWord: "test", EndWord: "best", K: 2, Result: 1},
+		{StartWord: "test", EndWord: "beat", K: 2, Result: 1},
+		{StartWord: "test", EndWord: "brat", K: 2, Result: 2},
+		{StartWord: "test", EndWord: "brag", K: 2, Result: 2},
+		{StartWord: "test", EndWord: "brag", K: 3, Result: 2},
+		{StartWord: "test", EndWord: "brag", K: 1, Result: 4},
+	}
+
+	//Loop through all test cases
+	for i, input := range testInputs {
+		fmt.Print("Test ", i+1, " of ", len(testInputs))
+		//Act
+		result := calculateMultiLetterNodeCost(input.StartWord, input.EndWord, input.K)
+
+		//Assert
+		if input.Result != result {
+			t.Error(
+				"Test number ", i+1, "\n",
+				"Given the inputs:\n",
+				"start word = ", input.StartWord, "\n",
+				"end word = ", input.EndWord, "\n",
+				"k = ", input.K, "\n",
+				"Expected result to be:\n",
+				"Result = ", input.Result, "\n",
+				"Actual result was:\n",
+				"Result = ", result, "\n",
+			)
+			fmt.Println(" - failed.")
+		} else {
+			fmt.Println(" - passed.")
+		}
+	}
+	fmt.Print("\n")
+}
+
 //Test the generate node children function will return the correct result for a given input
 func TestGenerateNodeChildren(t *testing.T) {
 	fmt.Println("Testing generating children nodes method: 'generateNodeChildren'....")
@@ -208,6 +250,9 @@ func TestGenerateNodeChildren(t *testing.T) {
 	wordNodeBeat := newAStarWordNode("beat")
 	wordNodeBrat := newAStarWordNode("brat")
 	wordNodeBrag := newAStarWordNode("brag")
+	wordNodePost := newAStarWordNode("post")
+	wordNodeBestFromTest := newAStarWordNode("best")
+	wordNodeBestFromTest.ParentNode = &wordNodeTest
 
 	inputNode1 := &wordNodeTest
 	inputDict1 := []*aStarWordNode{&wordNodePest, &wordNodeBest, &wordNodeBeat, &wordNodeBrat, &wordNodeBrag}
@@ -224,6 +269,31 @@ func TestGenerateNodeChildren(t *testing.T) {
 	resultChildren3 := []*aStarWordNode{&wordNodeBrat}
 	resultDict3 := []*aStarWordNode{&wordNodeTest, &wordNodeBest, &wordNodePest, &wordNodeBeat}
 
+	inputNode4 := &wordNodeTest
+	inputDict4 := []*aStarWordNode{&wordNodePest, &wordNodeBest, &wordNodeBeat, &wordNodeBrat, &wordNodeBrag}
+	resultChildren4 := []*aStarWordNode{&wordNodeBest}
+	resultDict4 := []*aStarWordNode{&wordNodeBeat, &wordNodeBrag}
+
+	inputNode5 := &wordNodeBest
+	inputDict5 := []*aStarWordNode{&wordNodeTest, &wordNodePest, &wordNodeBeat, &wordNodeBrat, &wordNodeBrag}
+	resultChildren5 := []*aStarWordNode{&wordNodeBeat}
+	resultDict5 := []*aStarWordNode{&wordNodeTest, &wordNodePest, &wordNodeBrat, &wordNodeBrag}
+
+	inputNode6 := &wordNodePest
+	inputDict6 := []*aStarWordNode{&wordNodeTest, &wordNodeBest, &wordNodePost, &wordNodeBrat}
+	resultChildren6 := []*aStarWordNode{&wordNodePost}
+	resultDict6 := []*aStarWordNode{&wordNodeTest, &wordNodeBest, &wordNodeBrat}
+
+	inputNode7 := &wordNodeBestFromTest
+	inputDict7 := []*aStarWordNode{&wordNodeTest, &wordNodePest, &wordNodeBeat, &wordNodeBrat, &wordNodeBrag}
+	resultChildren7 := []*aStarWordNode{&wordNodeBeat}
+	resultDict7 := []*aStarWordNode{&wordNodeTest, &wordNodePest, &wordNodeBrat, &wordNodeBrag}
+
+	inputNode8 := &wordNodeTest
+	inputDict8 := []*aStarWordNode{&wordNodePest, &wordNodeBest, &wordNodeBeat, &wordNodeBrat, &wordNodeBrag}
+	resultChildren8 := []*aStarWordNode{&wordNodePest, &wordNodeBest, &wordNodeBeat}
+	resultDict8 := []*aStarWordNode{&wordNodeBrat, &wordNodeBrag}
+
 	testInputs := []aStarGenerateNodeChildren{
 		{InputNode: inputNode1,
 			InputDictionary:     inputDict1,
@@ -237,12 +307,37 @@ func TestGenerateNodeChildren(t *testing.T) {
 			InputDictionary:     inputDict3,
 			ResultChildrenNodes: resultChildren3,
 			ResultDictionary:    resultDict3},
+		{InputNode: inputNode4,
+			InputDictionary:     inputDict4,
+			ResultChildrenNodes: resultChildren4,
+			ResultDictionary:    resultDict4,
+			Excluded:            map[string]bool{"pest": true, "brat": true}},
+		{InputNode: inputNode5,
+			InputDictionary:     inputDict5,
+			ResultChildrenNodes: resultChildren5,
+			ResultDictionary:    resultDict5,
+			Constraints:         MoveConstraints{LockedPositions: []int{0}}},
+		{InputNode: inputNode6,
+			InputDictionary:     inputDict6,
+			ResultChildrenNodes: resultChildren6,
+			ResultDictionary:    resultDict6,
+			Constraints:         MoveConstraints{VowelsOnly: true}},
+		{InputNode: inputNode7,
+			InputDictionary:     inputDict7,
+			ResultChildrenNodes: resultChildren7,
+			ResultDictionary:    resultDict7,
+			Constraints:         MoveConstraints{NoRepeatPosition: true}},
+		{InputNode: inputNode8,
+			InputDictionary:     inputDict8,
+			ResultChildrenNodes: resultChildren8,
+			ResultDictionary:    resultDict8,
+			MaxChanged:          2},
 	}
 
 	for i, input := range testInputs {
 		fmt.Print("Test ", i+1, " of ", len(testInputs))
 		//Act
-		resultChildren, resultDictionary := generateNodeChildren(input.InputNode, input.InputDictionary)
+		resultChildren, resultDictionary := generateNodeChildren(input.InputNode, input.InputDictionary, newMoveRules(input.Excluded, input.Constraints, input.MaxChanged))
 
 		//Assert
 		if !doNodePointerArraysMatch(input.ResultChildrenNodes, resultChildren) || !doNodePointerArraysMatch(input.ResultDictionary, resultDictionary) {
@@ -251,6 +346,9 @@ func TestGenerateNodeChildren(t *testing.T) {
 				"Given the inputs:\n",
 				"start node = ", input.InputNode.Word, "\n",
 				"Input Dictionary = ", convertNodePointersToNodes(input.InputDictionary), "\n",
+				"Excluded = ", input.Excluded, "\n",
+				"Constraints = ", input.Constraints, "\n",
+				"Max Changed = ", input.MaxChanged, "\n",
 				"Expected result to be:\n",
 				"Result Children = ", convertNodePointersToNodes(input.ResultChildrenNodes), "\n",
 				"Actual result was:\n",
@@ -14,9 +14,16 @@ type aStarCalculateNodeCostMockInput struct {
 	StartWord, EndWord string
 	Result             int
 }
+type aStarCalculateMultiLetterNodeCostMockInput struct {
+	StartWord, EndWord string
+	K, Result          int
+}
 type aStarGenerateNodeChildren struct {
 	InputNode                                              *aStarWordNode
 	InputDictionary, ResultChildrenNodes, ResultDictionary []*aStarWordNode
+	Excluded                                               map[string]bool
+	Constraints                                            MoveConstraints
+	MaxChanged                                             int
 }
 type aStarGetResultPath struct {
 	EndNode    aStarWordNode
@@ -0,0 +1,214 @@
+package wordPathAnalyser
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"os"
+	"path/filepath"
+)
+
+//Identifies a word graph cache file and the version of its layout, the version must be raised whenever the layout changes.
+const (
+	adjacencyCacheMagic   = "WPAG"
+	adjacencyCacheVersion = 1
+)
+
+//errInvalidAdjacencyCache is returned when a cache file is not a word graph cache, has a different version or is cut short.
+var errInvalidAdjacencyCache = errors.New("adjacency cache: file is not a valid word graph cache")
+
+//errStaleAdjacencyCache is returned when a cache file was built from a different word list.
+var errStaleAdjacencyCache = errors.New("adjacency cache: file was built from a different word list")
+
+//LoadWordDictionaryWithCache reads in a word file and creates a dictionary from it along with the one letter change graph of its words.
+//The graph is read straight from the cache file when it was built from the same word list,
+//otherwise the graph is built and the cache file is written so the next load can use it.
+//The cache is only written when it can be, if the write fails (for example the directory is read only) the dictionary still uses the built graph.
+//INPUTS: filelocation, delimiter, cache filelocation (strings) (**If delimiter is not to be used enter ""**)
+//OUTPUT: the loaded dictionary (*WordDictionary)
+func LoadWordDictionaryWithCache(fL, dL, cL string) *WordDictionary {
+	words := readWordList(fL, dL)
+	checksum := wordListChecksum(words)
+
+	graphs, err := readAdjacencyCache(cL, checksum)
+	if err != nil {
+		graphs = newWordGraphs(words)
+		//The graph is already built so a failed write only means the next load builds it again.
+		_ = writeAdjacencyCache(cL, checksum, graphs)
+	}
+
+	d := NewWordDictionary(words)
+	d.graphs = make(map[int]*wordGraph, len(graphs))
+	for _, graph := range graphs {
+		graph.indexWords()
+		d.graphs[graph.WordLength] = graph
+	}
+	return d
+}
+
+//Create the neighbour generator for a search that uses the dictionary's word graphs, nil if the dictionary has no graphs
+//or the search nodes include goal words that are not in the dictionary (and so not in the graphs).
+func (d *WordDictionary) graphNeighbours(wordDictionary []*aStarWordNode) func(word string) []string {
+	if d.graphs == nil {
+		return nil
+	}
+	for _, node := range wordDictionary {
+		if !d.Contains(node.Word) {
+			return nil
+		}
+	}
+	return d.Neighbours
+}
+
+//Calculate the checksum of a word list, every word is followed by a new line so the split between words is part of the checksum.
+func wordListChecksum(words []string) [sha256.Size]byte {
+	hash := sha256.New()
+	for _, word := range words {
+		hash.Write([]byte(word))
+		hash.Write([]byte{'\n'})
+	}
+	var checksum [sha256.Size]byte
+	copy(checksum[:], hash.Sum(nil))
+	return checksum
+}
+
+//Write the word graphs to a cache file. All numbers are little endian uint32s, the layout is:
+//magic, version, checksum of the word list, number of graphs, then for each graph:
+//word length, number of words, the words (word length bytes each), the offset of each word's edges (number of words + 1) and the edges.
+//The file is written to a temporary file first so a reader never sees a part written cache.
+func writeAdjacencyCache(cL string, checksum [sha256.Size]byte, graphs []*wordGraph) error {
+	file, err := os.CreateTemp(filepath.Dir(cL), filepath.Base(cL)+".tmp*")
+	if err != nil {
+		return err
+	}
+	//Remove the temporary file if it was not renamed.
+	defer os.Remove(file.Name())
+
+	w := bufio.NewWriter(file)
+	//Write a number, any error is kept by the writer and returned by Flush.
+	writeUint32 := func(n int) {
+		binary.Write(w, binary.LittleEndian, uint32(n))
+	}
+
+	w.WriteString(adjacencyCacheMagic)
+	writeUint32(adjacencyCacheVersion)
+	w.Write(checksum[:])
+	writeUint32(len(graphs))
+	for _, graph := range graphs {
+		writeUint32(graph.WordLength)
+		writeUint32(len(graph.Words))
+		for _, word := range graph.Words {
+			w.WriteString(word)
+		}
+		offset := 0
+		for _, edges := range graph.Edges {
+			writeUint32(offset)
+			offset += len(edges)
+		}
+		writeUint32(offset)
+		for _, edges := range graph.Edges {
+			for _, edge := range edges {
+				writeUint32(edge)
+			}
+		}
+	}
+
+	if err := w.Flush(); err != nil {
+		file.Close()
+		return err
+	}
+	if err := file.Close(); err != nil {
+		return err
+	}
+	return os.Rename(file.Name(), cL)
+}
+
+//Read the word graphs from a cache file, an error is returned if the file cannot be read, is not valid or was built from a different word list.
+func readAdjacencyCache(cL string, checksum [sha256.Size]byte) ([]*wordGraph, error) {
+	data, err := os.ReadFile(cL)
+	if err != nil {
+		return nil, err
+	}
+	return decodeAdjacencyCache(data, checksum)
+}
+
+//Decode the word graphs from the contents of a cache file (see writeAdjacencyCache for the layout).
+func decodeAdjacencyCache(data []byte, checksum [sha256.Size]byte) ([]*wordGraph, error) {
+	//Position of the next byte to read.
+	position := 0
+	//Read the next n bytes, nil if the data is cut short.
+	next := func(n int) []byte {
+		if n < 0 || len(data)-position < n {
+			return nil
+		}
+		position += n
+		return data[position-n : position]
+	}
+	//Read the next number, -1 if the data is cut short.
+	nextInt := func() int {
+		if b := next(4); b != nil {
+			return int(binary.LittleEndian.Uint32(b))
+		}
+		return -1
+	}
+
+	if string(next(len(adjacencyCacheMagic))) != adjacencyCacheMagic || nextInt() != adjacencyCacheVersion {
+		return nil, errInvalidAdjacencyCache
+	}
+	if fileChecksum := next(sha256.Size); fileChecksum == nil || !bytes.Equal(fileChecksum, checksum[:]) {
+		return nil, errStaleAdjacencyCache
+	}
+
+	graphCount := nextInt()
+	if graphCount < 0 {
+		return nil, errInvalidAdjacencyCache
+	}
+	graphs := make([]*wordGraph, 0, graphCount)
+	for i := 0; i < graphCount; i++ {
+		graph := &wordGraph{WordLength: nextInt()}
+		wordCount := nextInt()
+		//Check the counts against the data left before any space is made for them.
+		if graph.WordLength < 0 || wordCount < 0 || wordCount*4 > len(data)-position {
+			return nil, errInvalidAdjacencyCache
+		}
+		words := next(graph.WordLength * wordCount)
+		if words == nil {
+			return nil, errInvalidAdjacencyCache
+		}
+		graph.Words = make([]string, wordCount)
+		for id := range graph.Words {
+			graph.Words[id] = string(words[id*graph.WordLength : (id+1)*graph.WordLength])
+		}
+
+		offsets := make([]int, wordCount+1)
+		for id := range offsets {
+			offsets[id] = nextInt()
+			if offsets[id] < 0 || (id > 0 && offsets[id] < offsets[id-1]) {
+				return nil, errInvalidAdjacencyCache
+			}
+		}
+		if offsets[wordCount]*4 > len(data)-position {
+			return nil, errInvalidAdjacencyCache
+		}
+		//Every edge is read into one slice which each word's edges are a part of.
+		edges := make([]int, offsets[wordCount])
+		for j := range edges {
+			edges[j] = nextInt()
+			if edges[j] < 0 || edges[j] >= wordCount {
+				return nil, errInvalidAdjacencyCache
+			}
+		}
+		graph.Edges = make([][]int, wordCount)
+		for id := range graph.Edges {
+			graph.Edges[id] = edges[offsets[id]:offsets[id+1]:offsets[id+1]]
+		}
+		graphs = append(graphs, graph)
+	}
+
+	if position != len(data) {
+		return nil, errInvalidAdjacencyCache
+	}
+	return graphs, nil
+}
@@ -0,0 +1,16 @@
+package wordPathAnalyser
+
+//Structs used to hold the mocked input.
+type adjacencyCacheMockInput struct {
+	SourceWords      []string
+	CacheChange      string
+	CacheValidBefore bool
+	Word             string
+	Neighbours       []string
+}
+
+type unwritableCacheMockInput struct {
+	CacheFile  string
+	Word       string
+	Neighbours []string
+}
@@ -0,0 +1,134 @@
+package wordPathAnalyser
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+//Test that the adjacency cache is written, read back while the word list is the same and rebuilt when the word list or the cache changes.
+func TestLoadWordDictionaryWithCache(t *testing.T) {
+	fmt.Println("Testing dictionary adjacency cache method: 'LoadWordDictionaryWithCache'....")
+
+	//Arrange
+	directory := t.TempDir()
+	source := filepath.Join(directory, "words.txt")
+	cache := filepath.Join(directory, "words.cache")
+	words := readWordList("./testInputGraph.txt", "")
+	moreWords := append(append([]string{}, words...), "wore")
+	//Each test runs in order on the same source and cache files.
+	testInputs := []adjacencyCacheMockInput{
+		{SourceWords: words, CacheValidBefore: false, Word: "cord", Neighbours: []string{"cold", "card", "word", "core"}},
+		{SourceWords: words, CacheValidBefore: true, Word: "cord", Neighbours: []string{"cold", "card", "word", "core"}},
+		{SourceWords: moreWords, CacheValidBefore: false, Word: "core", Neighbours: []string{"cord", "care", "wore"}},
+		{SourceWords: moreWords, CacheChange: "truncate", CacheValidBefore: false, Word: "cot", Neighbours: []string{"cat", "dot", "cog"}},
+		{SourceWords: moreWords, CacheChange: "version", CacheValidBefore: false, Word: "cot", Neighbours: []string{"cat", "dot", "cog"}},
+		{SourceWords: moreWords, CacheValidBefore: true, Word: "hold", Neighbours: []string{"cold", "gold", "bold"}},
+	}
+
+	for i, input := range testInputs {
+		fmt.Print("Test ", i+1, " of ", len(testInputs))
+		if err := os.WriteFile(source, []byte(strings.Join(input.SourceWords, "\n")), 0644); err != nil {
+			t.Fatal(err)
+		}
+		changeCacheFile(t, cache, input.CacheChange)
+		_, err := readAdjacencyCache(cache, wordListChecksum(input.SourceWords))
+
+		//Act
+		dictionary := LoadWordDictionaryWithCache(source, "", cache)
+
+		//Assert
+		_, errAfter := readAdjacencyCache(cache, wordListChecksum(input.SourceWords))
+		neighbours := dictionary.Neighbours(input.Word)
+		pathFound, resultPath := dictionary.AStarAnalyseWithOptions("cold", "warm", SearchOptions{})
+		expectedFound, expectedPath := NewWordDictionary(input.SourceWords).AStarAnalyseWithOptions("cold", "warm", SearchOptions{Neighbours: NeighbourScan})
+		if (err == nil) != input.CacheValidBefore || errAfter != nil || !doArraysMatch(input.Neighbours, neighbours) ||
+			pathFound != expectedFound || !doArraysMatch(expectedPath, resultPath) {
+			t.Error(
+				"Test number ", i+1, "\n",
+				"Given the inputs:\n",
+				"source words = ", input.SourceWords, "\n",
+				"cache change = ", input.CacheChange, "\n",
+				"word = ", input.Word, "\n",
+				"Expected results to be:\n",
+				"Cache Valid Before = ", input.CacheValidBefore, "\n",
+				"Neighbours = ", input.Neighbours, "\n",
+				"Result Path = ", expectedPath, "\n",
+				"Actual results were:\n",
+				"Cache Error Before = ", err, "\n",
+				"Cache Error After = ", errAfter, "\n",
+				"Neighbours = ", neighbours, "\n",
+				"Result Path = ", resultPath, "\n",
+			)
+			fmt.Println(" - failed.")
+		} else {
+			fmt.Println(" - passed.")
+		}
+	}
+	fmt.Print("\n")
+}
+
+//Test that the dictionary still uses the built word graph when the cache file cannot be written.
+func TestLoadWordDictionaryWithUnwritableCache(t *testing.T) {
+	fmt.Println("Testing dictionary adjacency cache method: 'LoadWordDictionaryWithCache' (unwritable cache)....")
+
+	//Arrange
+	directory := t.TempDir()
+	testInputs := []unwritableCacheMockInput{
+		{CacheFile: filepath.Join(directory, "missing", "words.cache"), Word: "cord", Neighbours: []string{"cold", "card", "word", "core"}},
+		{CacheFile: directory, Word: "cot", Neighbours: []string{"cat", "dot", "cog"}},
+	}
+
+	for i, input := range testInputs {
+		fmt.Print("Test ", i+1, " of ", len(testInputs))
+		//Act
+		dictionary := LoadWordDictionaryWithCache("./testInputGraph.txt", "", input.CacheFile)
+
+		//Assert
+		_, err := readAdjacencyCache(input.CacheFile, wordListChecksum(readWordList("./testInputGraph.txt", "")))
+		neighbours := dictionary.Neighbours(input.Word)
+		if dictionary.graphs == nil || err == nil || !doArraysMatch(input.Neighbours, neighbours) {
+			t.Error(
+				"Test number ", i+1, "\n",
+				"Given the inputs:\n",
+				"cache file = ", input.CacheFile, "\n",
+				"word = ", input.Word, "\n",
+				"Expected results to be:\n",
+				"Graphs Built = true\n",
+				"Cache Written = false\n",
+				"Neighbours = ", input.Neighbours, "\n",
+				"Actual results were:\n",
+				"Graphs Built = ", dictionary.graphs != nil, "\n",
+				"Cache Written = ", err == nil, "\n",
+				"Neighbours = ", neighbours, "\n",
+			)
+			fmt.Println(" - failed.")
+		} else {
+			fmt.Println(" - passed.")
+		}
+	}
+	fmt.Print("\n")
+}
+
+//-----------INTERNAL FUNCTIONS-----------\\
+//Damage the cache file, "truncate" cuts off the last byte and "version" changes the version number.
+func changeCacheFile(t *testing.T, cache, change string) {
+	if change == "" {
+		return
+	}
+	data, err := os.ReadFile(cache)
+	if err != nil {
+		t.Fatal(err)
+	}
+	switch change {
+	case "truncate":
+		data = data[:len(data)-1]
+	case "version":
+		data[len(adjacencyCacheMagic)]++
+	}
+	if err := os.WriteFile(cache, data, 0644); err != nil {
+		t.Fatal(err)
+	}
+}
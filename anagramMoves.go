@@ -0,0 +1,123 @@
+package wordPathAnalyser
+
+import "sort"
+
+//Anagrams returns every dictionary word made of the same letters as the word, apart from the word itself (in file order).
+func (d *WordDictionary) Anagrams(word string) []string {
+	anagrams := make([]string, 0)
+	for _, anagram := range d.anagrams[sortedLetters(word)] {
+		if anagram != word {
+			anagrams = append(anagrams, anagram)
+		}
+	}
+	return anagrams
+}
+
+//Create the anagram index key for a word, its letters in sorted order.
+func sortedLetters(word string) string {
+	letters := []byte(word)
+	sort.Slice(letters, func(i, j int) bool { return letters[i] < letters[j] })
+	return string(letters)
+}
+
+//Check if the only difference between two words of the same length is that two letters next to each other have been swapped.
+func isAdjacentSwap(from, to string) bool {
+	for i := 0; i < len(from); i++ {
+		if from[i] == to[i] {
+			continue
+		}
+		return i+1 < len(from) && from[i] == to[i+1] && from[i+1] == to[i] && from[i+2:] == to[i+2:]
+	}
+	return false
+}
+
+//Find the anagram index to search with, goal words given as targets that are not in the dictionary are added to a copy of the index.
+func (d *WordDictionary) goalAnagrams(g SearchGoal) map[string][]string {
+	anagrams := d.anagrams
+	if g.wildcards {
+		return anagrams
+	}
+	copied := false
+	for _, target := range g.targets {
+		key := sortedLetters(target)
+		if d.Contains(target) || indexOf(anagrams[key], target) != -1 {
+			continue
+		}
+		if !copied {
+			anagrams = make(map[string][]string, len(d.anagrams)+len(g.targets))
+			for k, words := range d.anagrams {
+				anagrams[k] = words
+			}
+			copied = true
+		}
+		//The word list is copied so the dictionary's list is never changed.
+		anagrams[key] = append(append([]string{}, anagrams[key]...), target)
+	}
+	return anagrams
+}
+
+//Make a step that rearranges letters cost the rearrangement cost, a step that is also a letter change costs whichever is lower.
+//A step is only a rearrangement when the move rules allow it, any anagram with anagram moves or two letters next to each other swapped with swap moves.
+func rearrangeStepCost(letterStepCost func(from, to string) int, rearrangeCost int, moves *moveRules) func(from, to string) int {
+	return func(from, to string) int {
+		isRearrangement := sortedLetters(from) == sortedLetters(to) && (moves.anagrams != nil || (moves.swaps && isAdjacentSwap(from, to)))
+		if !isRearrangement {
+			return letterStepCost(from, to)
+		}
+		if cost := letterStepCost(from, to); cost < rearrangeCost && calculateNodeCost(from, to) <= moves.maxChanged {
+			return cost
+		}
+		return rearrangeCost
+	}
+}
+
+//Find the anagram moves from a node that are not already children, searchNode finds the search node of a word that can be moved to (nil if it cannot).
+func (m *moveRules) anagramChildren(node *aStarWordNode, children []*aStarWordNode, searchNode func(word string) *aStarWordNode) []*aStarWordNode {
+	if m == nil || m.anagrams == nil {
+		return nil
+	}
+	//Set of the words that are already children.
+	isChild := make(map[string]bool, len(children))
+	for _, child := range children {
+		isChild[child.Word] = true
+	}
+
+	anagramNodes := make([]*aStarWordNode, 0)
+	for _, anagram := range m.anagrams[sortedLetters(node.Word)] {
+		if anagram == node.Word || isChild[anagram] || m.isExcluded(anagram) || searchNode(anagram) == nil || !m.allows(node, anagram) {
+			continue
+		}
+		anagramNodes = append(anagramNodes, searchNode(anagram))
+	}
+	return anagramNodes
+}
+
+//Calculate the minimum potential cost from one word to another when letters can also be rearranged.
+//Rearranging does not change which letters a word has, so only the letters the end word has more of need to be changed.
+//Each of those letters costs letterCost and up to k can be changed in a step, words made of the same letters still need a step of at least minStepCost.
+func calculateRearrangeNodeCost(s, e string, k int, letterCost func(letter byte) int, minStepCost int) int {
+	if s == e {
+		return 0
+	}
+	//Number of each letter in the start word not yet matched to a letter in the end word.
+	var counts [256]int
+	for i := 0; i < len(s); i++ {
+		counts[s[i]]++
+	}
+
+	result := 0
+	for i := 0; i < len(e); i++ {
+		if counts[e[i]] > 0 {
+			counts[e[i]]--
+		} else {
+			result += letterCost(e[i])
+		}
+	}
+	//Round up as a step that changes fewer than k letters is still a step.
+	result = (result + k - 1) / k
+
+	if result < minStepCost {
+		return minStepCost
+	}
+	return result
+}
@@ -0,0 +1,16 @@
+package wordPathAnalyser
+
+//Structs used to hold the mocked input.
+type anagramsMockInput struct {
+	Word   string
+	Result []string
+}
+type isAdjacentSwapMockInput struct {
+	From, To string
+	Result   bool
+}
+type rearrangeNodeCostMockInput struct {
+	StartWord, EndWord string
+	K, MinStepCost     int
+	Result             int
+}
@@ -0,0 +1,191 @@
+package wordPathAnalyser
+
+import (
+	"fmt"
+	"testing"
+)
+
+//Test that the anagram index returns every other word made of the same letters.
+func TestWordDictionaryAnagrams(t *testing.T) {
+	fmt.Println("Testing dictionary anagram method: 'Anagrams'....")
+
+	//Arrange
+	dictionary := NewWordDictionary([]string{"stop", "pots", "tops", "spot", "pest", "opts"})
+	testInputs := []anagramsMockInput{
+		{Word: "stop", Result: []string{"pots", "tops", "spot", "opts"}},
+		{Word: "post", Result: []string{"stop", "pots", "tops", "spot", "opts"}},
+		{Word: "pest", Result: []string{}},
+		{Word: "step", Result: []string{"pest"}},
+		{Word: "cat", Result: []string{}},
+	}
+
+	for i, input := range testInputs {
+		fmt.Print("Test ", i+1, " of ", len(testInputs))
+		//Act
+		result := dictionary.Anagrams(input.Word)
+
+		//Assert
+		if !doArraysMatch(input.Result, result) {
+			t.Error(
+				"Test number ", i+1, "\n",
+				"Given the inputs:\n",
+				"word = ", input.Word, "\n",
+				"Expected result to be:\n",
+				input.Result, "\n",
+				"Actual result was:\n",
+				result, "\n",
+			)
+			fmt.Println(" - failed.")
+		} else {
+			fmt.Println(" - passed.")
+		}
+	}
+	fmt.Print("\n")
+}
+
+//Test that only a swap of two letters next to each other is found.
+func TestIsAdjacentSwap(t *testing.T) {
+	fmt.Println("Testing adjacent swap method: 'isAdjacentSwap'....")
+
+	//Arrange
+	testInputs := []isAdjacentSwapMockInput{
+		{From: "form", To: "from", Result: true},
+		{From: "abcd", To: "bacd", Result: true},
+		{From: "abcd", To: "abdc", Result: true},
+		{From: "abcd", To: "badc", Result: false},
+		{From: "stop", To: "tops", Result: false},
+		{From: "abcd", To: "cbad", Result: false},
+		{From: "test", To: "test", Result: false},
+	}
+
+	for i, input := range testInputs {
+		fmt.Print("Test ", i+1, " of ", len(testInputs))
+		//Act
+		result := isAdjacentSwap(input.From, input.To)
+
+		//Assert
+		if result != input.Result {
+			t.Error(
+				"Test number ", i+1, "\n",
+				"Given the inputs:\n",
+				"from = ", input.From, "\n",
+				"to = ", input.To, "\n",
+				"Expected result to be:\n",
+				input.Result, "\n",
+				"Actual result was:\n",
+				result, "\n",
+			)
+			fmt.Println(" - failed.")
+		} else {
+			fmt.Println(" - passed.")
+		}
+	}
+	fmt.Print("\n")
+}
+
+//Test the rearrange node cost only counts the letters that have to change.
+func TestCalculateRearrangeNodeCost(t *testing.T) {
+	fmt.Println("Testing rearrange node cost calculation method: 'calculateRearrangeNodeCost'....")
+
+	//Arrange
+	testInputs := []rearrangeNodeCostMockInput{
+		{StartWord: "stop", EndWord: "stop", K: 1, MinStepCost: 1, Result: 0},
+		{StartWord: "stop", EndWord: "pots", K: 1, MinStepCost: 1, Result: 1},
+		{StartWord: "stop", EndWord: "pots", K: 1, MinStepCost: 3, Result: 3},
+		{StartWord: "stop", EndWord: "pits", K: 1, MinStepCost: 1, Result: 1},
+		{StartWord: "test", EndWord: "most", K: 1, MinStepCost: 1, Result: 2},
+		{StartWord: "test", EndWord: "most", K: 2, MinStepCost: 1, Result: 1},
+		{StartWord: "test", EndWord: "brag", K: 1, MinStepCost: 1, Result: 4},
+		{StartWord: "test", EndWord: "sett", K: 1, MinStepCost: 1, Result: 1},
+	}
+
+	for i, input := range testInputs {
+		fmt.Print("Test ", i+1, " of ", len(testInputs))
+		//Act
+		result := calculateRearrangeNodeCost(input.StartWord, input.EndWord, input.K, func(letter byte) int { return 1 }, input.MinStepCost)
+
+		//Assert
+		if result != input.Result {
+			t.Error(
+				"Test number ", i+1, "\n",
+				"Given the inputs:\n",
+				"start word = ", input.StartWord, "\n",
+				"end word = ", input.EndWord, "\n",
+				"k = ", input.K, "\n",
+				"min step cost = ", input.MinStepCost, "\n",
+				"Expected result to be:\n",
+				input.Result, "\n",
+				"Actual result was:\n",
+				result, "\n",
+			)
+			fmt.Println(" - failed.")
+		} else {
+			fmt.Println(" - passed.")
+		}
+	}
+	fmt.Print("\n")
+}
+
+//Test that swap and anagram moves are used by the A* search.
+func TestWordDictionaryAStarAnalyseWithRearrangements(t *testing.T) {
+	fmt.Println("Testing dictionary rearranging A* method: 'AStarAnalyseWithOptions'....")
+
+	//Arrange
+	dictionary := NewWordDictionary([]string{"stop", "pots", "pits", "form", "from", "frog", "foam", "ioo", "iia", "aoo", "aii", "ppa", "ppq", "qpp"})
+	testInputs := []weightedAStarAnalyseMockInput{
+		{StartWord: "stop", EndWord: "pits", Options: SearchOptions{},
+			PathFound: false, ResultPath: []string{}},
+		{StartWord: "stop", EndWord: "pits", Options: SearchOptions{AnagramMoves: true},
+			PathFound: true, ResultPath: []string{"pits", "pots", "stop"}},
+		{StartWord: "stop", EndWord: "pits", Options: SearchOptions{SwapMoves: true},
+			PathFound: false, ResultPath: []string{}},
+		{StartWord: "stop", EndWord: "pits", Options: SearchOptions{AnagramMoves: true, PreferCommonWords: true},
+			PathFound: true, ResultPath: []string{"pits", "pots", "stop"}},
+		{StartWord: "stop", EndWord: "pits", Options: SearchOptions{AnagramMoves: true, Substitutions: VowelConsonantCostMatrix()},
+			PathFound: true, ResultPath: []string{"pits", "pots", "stop"}},
+		{StartWord: "stop", EndWord: "pits", Options: SearchOptions{AnagramMoves: true, Constraints: MoveConstraints{LockedPositions: []int{0}}},
+			PathFound: false, ResultPath: []string{}},
+		{StartWord: "stop", EndWord: "post", Options: SearchOptions{AnagramMoves: true},
+			PathFound: true, ResultPath: []string{"post", "stop"}},
+		{StartWord: "form", EndWord: "frog", Options: SearchOptions{},
+			PathFound: false, ResultPath: []string{}},
+		{StartWord: "form", EndWord: "frog", Options: SearchOptions{SwapMoves: true},
+			PathFound: true, ResultPath: []string{"frog", "from", "form"}},
+		{StartWord: "form", EndWord: "frog", Options: SearchOptions{AnagramMoves: true},
+			PathFound: true, ResultPath: []string{"frog", "from", "form"}},
+		{StartWord: "form", EndWord: "frog", Options: SearchOptions{SwapMoves: true, Exclude: []string{"from"}},
+			PathFound: false, ResultPath: []string{}},
+		//Swapping letters that are not next to each other is a 2 letter change, not a swap move, so iia to aii costs 2 and ppq to qpp costs 18.
+		{StartWord: "ioo", EndWord: "aii", Options: SearchOptions{SwapMoves: true, MaxLettersChanged: 2, CostPerLetterChanged: true},
+			PathFound: true, ResultPath: []string{"aii", "aoo", "ioo"}},
+		{StartWord: "ppa", EndWord: "qpp", Options: SearchOptions{SwapMoves: true, MaxLettersChanged: 2, Substitutions: KeyboardDistanceCostMatrix()},
+			PathFound: true, ResultPath: []string{"qpp", "ppa"}},
+	}
+
+	for i, input := range testInputs {
+		fmt.Print("Test ", i+1, " of ", len(testInputs))
+		//Act
+		pathFound, resultPath := dictionary.AStarAnalyseWithOptions(input.StartWord, input.EndWord, input.Options)
+
+		//Assert
+		if pathFound != input.PathFound || !doArraysMatch(input.ResultPath, resultPath) {
+			t.Error(
+				"Test number ", i+1, "\n",
+				"Given the inputs:\n",
+				"start word = ", input.StartWord, "\n",
+				"end word = ", input.EndWord, "\n",
+				"options = ", input.Options, "\n",
+				"Expected results to be:\n",
+				"Path Found = ", input.PathFound, "\n",
+				"Result Path = ", input.ResultPath, "\n",
+				"Actual results were:\n",
+				"Path Found = ", pathFound, "\n",
+				"Result Path = ", resultPath, "\n",
+			)
+			fmt.Println(" - failed.")
+		} else {
+			fmt.Println(" - passed.")
+		}
+	}
+	fmt.Print("\n")
+}
@@ -0,0 +1,80 @@
+package wordPathAnalyser
+
+import "strings"
+
+//DigitAlphabet holds the digits of a combination lock wheel in order, 9 wraps round to 0.
+const DigitAlphabet = "0123456789"
+
+//AStarAnalyseLock uses the A* Graphing Algorythm to find the fewest turns to open a combination lock, one digit wheel is turned one place each step.
+//INPUTS: start combination, combination that opens the lock (strings), dead end combinations that cannot be turned to ([]string)
+//OUTPUT: path found result (Boolean), path from the opening combination to the start combination ([]string) (if no path is found emtpy array is returned)
+func AStarAnalyseLock(sW, eW string, deadEnds []string) (foundResult bool, resultPath []string) {
+	return AStarAnalyseCircular(sW, eW, DigitAlphabet, deadEnds)
+}
+
+//AStarAnalyseCircular uses the A* Graphing Algorythm to find the fewest steps between two words where each step turns one letter
+//to the letter before or after it in a circular alphabet (the last letter is next to the first).
+//Every combination of letters can be used apart from the dead ends, so no dictionary is needed.
+//INPUTS: startword, endword, alphabet in order (strings), dead end words that cannot be stepped to ([]string)
+//OUTPUT: path found result (Boolean), path from end word to start word ([]string) (if no path is found emtpy array is returned)
+func AStarAnalyseCircular(sW, eW, alphabet string, deadEnds []string) (foundResult bool, resultPath []string) {
+	//Set of the dead ends.
+	excluded := make(map[string]bool, len(deadEnds))
+	for _, word := range deadEnds {
+		excluded[word] = true
+	}
+	if len(sW) != len(eW) || excluded[sW] || !isInAlphabet(sW, alphabet) || !isInAlphabet(eW, alphabet) {
+		return false, []string{}
+	}
+
+	rules := searchRules{
+		stepCost:   func(from, to string) int { return 1 },
+		estimateTo: func(word, target string) int { return calculateCircularNodeCost(word, target, alphabet) },
+		goal:       GoalWord(eW),
+		moves:      newMoveRules(excluded, MoveConstraints{}, 1),
+		neighbours: func(word string) []string { return circularNeighbours(word, alphabet) },
+	}
+	return weightedAStarAnalyse([]string{sW}, newSearchState(nil), rules)
+}
+
+//Generate every word made by turning one letter of the word one place either way round the circular alphabet.
+func circularNeighbours(word, alphabet string) []string {
+	neighbours := make([]string, 0, 2*len(word))
+	letters := []byte(word)
+	for i := range letters {
+		index := strings.IndexByte(alphabet, word[i])
+		for _, turn := range []int{1, len(alphabet) - 1} {
+			letters[i] = alphabet[(index+turn)%len(alphabet)]
+			//With an alphabet of 1 or 2 letters turning may give the same word or the same neighbour both ways.
+			if neighbour := string(letters); neighbour != word && (len(neighbours) == 0 || neighbours[len(neighbours)-1] != neighbour) {
+				neighbours = append(neighbours, neighbour)
+			}
+		}
+		letters[i] = word[i]
+	}
+	return neighbours
+}
+
+//Calculate the minimum potential cost from one word to another in a circular alphabet.
+//Each letter needs at least as many turns as the shortest way round the alphabet to the end word's letter.
+func calculateCircularNodeCost(s, e, alphabet string) int {
+	result := 0
+	for i := 0; i < len(s); i++ {
+		distance := absInt(strings.IndexByte(alphabet, s[i]) - strings.IndexByte(alphabet, e[i]))
+		if len(alphabet)-distance < distance {
+			distance = len(alphabet) - distance
+		}
+		result += distance
+	}
+	return result
+}
+
+//Check if every letter of the word is in the alphabet.
+func isInAlphabet(word, alphabet string) bool {
+	for i := 0; i < len(word); i++ {
+		if strings.IndexByte(alphabet, word[i]) == -1 {
+			return false
+		}
+	}
+	return true
+}
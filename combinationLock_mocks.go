@@ -0,0 +1,13 @@
+package wordPathAnalyser
+
+//Structs used to hold the mocked input.
+type circularAStarAnalyseMockInput struct {
+	StartWord, EndWord, Alphabet string
+	DeadEnds                     []string
+	PathFound                    bool
+	ResultPathLength             int
+}
+type circularNeighboursMockInput struct {
+	Word, Alphabet string
+	Result         []string
+}
@@ -0,0 +1,108 @@
+package wordPathAnalyser
+
+import (
+	"fmt"
+	"testing"
+)
+
+//Test the fewest turns are found to open a lock while avoiding the dead ends.
+func TestAStarAnalyseCircular(t *testing.T) {
+	fmt.Println("Testing circular alphabet A* method: 'AStarAnalyseCircular'....")
+
+	//Arrange
+	testInputs := []circularAStarAnalyseMockInput{
+		{StartWord: "0000", EndWord: "0202", Alphabet: DigitAlphabet, DeadEnds: []string{"0201", "0101", "0102", "1212", "2002"},
+			PathFound: true, ResultPathLength: 7},
+		{StartWord: "0000", EndWord: "0009", Alphabet: DigitAlphabet, DeadEnds: []string{},
+			PathFound: true, ResultPathLength: 2},
+		{StartWord: "0000", EndWord: "8888", Alphabet: DigitAlphabet, DeadEnds: []string{"8887", "8889", "8878", "8898", "8788", "8988", "7888", "9888"},
+			PathFound: false, ResultPathLength: 0},
+		{StartWord: "0000", EndWord: "8888", Alphabet: DigitAlphabet, DeadEnds: []string{"0000"},
+			PathFound: false, ResultPathLength: 0},
+		{StartWord: "0000", EndWord: "0000", Alphabet: DigitAlphabet, DeadEnds: []string{},
+			PathFound: true, ResultPathLength: 1},
+		{StartWord: "0000", EndWord: "000a", Alphabet: DigitAlphabet, DeadEnds: []string{},
+			PathFound: false, ResultPathLength: 0},
+		{StartWord: "aa", EndWord: "cc", Alphabet: "abcde", DeadEnds: []string{"ea"},
+			PathFound: true, ResultPathLength: 5},
+	}
+
+	for i, input := range testInputs {
+		fmt.Print("Test ", i+1, " of ", len(testInputs))
+		//Act
+		pathFound, resultPath := AStarAnalyseCircular(input.StartWord, input.EndWord, input.Alphabet, input.DeadEnds)
+
+		//Assert
+		if pathFound != input.PathFound || len(resultPath) != input.ResultPathLength || !isCircularLadder(resultPath, input.Alphabet, input.DeadEnds) {
+			t.Error(
+				"Test number ", i+1, "\n",
+				"Given the inputs:\n",
+				"start word = ", input.StartWord, "\n",
+				"end word = ", input.EndWord, "\n",
+				"alphabet = ", input.Alphabet, "\n",
+				"dead ends = ", input.DeadEnds, "\n",
+				"Expected results to be:\n",
+				"Path Found = ", input.PathFound, "\n",
+				"Result Path Length = ", input.ResultPathLength, "\n",
+				"Actual results were:\n",
+				"Path Found = ", pathFound, "\n",
+				"Result Path = ", resultPath, "\n",
+			)
+			fmt.Println(" - failed.")
+		} else {
+			fmt.Println(" - passed.")
+		}
+	}
+	fmt.Print("\n")
+}
+
+//Test every word one turn away is generated.
+func TestCircularNeighbours(t *testing.T) {
+	fmt.Println("Testing circular neighbour generation method: 'circularNeighbours'....")
+
+	//Arrange
+	testInputs := []circularNeighboursMockInput{
+		{Word: "09", Alphabet: DigitAlphabet, Result: []string{"19", "99", "00", "08"}},
+		{Word: "5", Alphabet: DigitAlphabet, Result: []string{"6", "4"}},
+		{Word: "ab", Alphabet: "ab", Result: []string{"bb", "aa"}},
+		{Word: "aa", Alphabet: "a", Result: []string{}},
+	}
+
+	for i, input := range testInputs {
+		fmt.Print("Test ", i+1, " of ", len(testInputs))
+		//Act
+		result := circularNeighbours(input.Word, input.Alphabet)
+
+		//Assert
+		if !doArraysMatch(input.Result, result) {
+			t.Error(
+				"Test number ", i+1, "\n",
+				"Given the inputs:\n",
+				"word = ", input.Word, "\n",
+				"alphabet = ", input.Alphabet, "\n",
+				"Expected result to be:\n",
+				input.Result, "\n",
+				"Actual result was:\n",
+				result, "\n",
+			)
+			fmt.Println(" - failed.")
+		} else {
+			fmt.Println(" - passed.")
+		}
+	}
+	fmt.Print("\n")
+}
+
+//-----------INTERNAL FUNCTIONS-----------\\
+//Check every step of a path turns one letter one place and no dead end is used.
+func isCircularLadder(path []string, alphabet string, deadEnds []string) bool {
+	for i, word := range path {
+		if indexOf(deadEnds, word) != -1 {
+			return false
+		}
+		if i > 0 && calculateCircularNodeCost(path[i-1], word, alphabet) != 1 {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,166 @@
+package wordPathAnalyser
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+)
+
+//DNAAlphabet holds the four DNA bases.
+const DNAAlphabet = "ACGT"
+
+//Longest line a FASTA file can have, a sequence that is not split over lines can be as long as a whole genome.
+const maxFASTALineLength = 1 << 30
+
+//FASTARecord is a single named sequence read from a FASTA file.
+type FASTARecord struct {
+	//First word of the header line (after the >) and the rest of the header.
+	Name, Description string
+	//The bases of the sequence in upper case.
+	Sequence string
+}
+
+//Mutation is a single base change between two DNA sequences.
+type Mutation struct {
+	//Position of the base from an index of 1, as used in mutation notation.
+	Position int
+	//The base before and after the change.
+	From, To byte
+}
+
+//DNAMutationPath holds the lowest cost series of mutations found between two DNA sequences.
+type DNAMutationPath struct {
+	//True when a path was found.
+	PathFound bool
+	//Every sequence from the start sequence to the end sequence.
+	Sequences []string
+	//The mutation made by each step.
+	Mutations []Mutation
+	//Total cost of the mutations.
+	Cost int
+}
+
+//TransitionTransversionCostMatrix creates a cost matrix for DNA bases where a transition (A to G, C to T and back) costs the transition cost
+//and a transversion (a purine to a pyrimidine or the other way round) costs the transversion cost. Other characters cost the transversion cost.
+func TransitionTransversionCostMatrix(transition, transversion int) *SubstitutionCostMatrix {
+	m := NewSubstitutionCostMatrix(transversion)
+	for _, pair := range []string{"AG", "GA", "CT", "TC"} {
+		m.SetCost(pair[0], pair[1], transition)
+	}
+	return m
+}
+
+//ReadFASTAFile reads in every record of a FASTA file, a sequence may be split over many lines and blank lines are ignored.
+//Bases are read in upper case, a record holding anything other than A, C, G or T (such as N or another IUPAC ambiguity code) is skipped
+//and its name returned, as a mutation path only changes between the four bases.
+//INPUTS: filelocation (string)
+//OUTPUT: records in file order ([]FASTARecord), names of the records skipped ([]string),
+//error if the file cannot be read, has a line longer than 1GiB or has a sequence before a header line (error)
+func ReadFASTAFile(fL string) (records []FASTARecord, skipped []string, err error) {
+	file, err := os.Open(fL)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer file.Close()
+
+	records, skipped = make([]FASTARecord, 0), make([]string, 0)
+	//The record being read (nil before the first header line) and its lines of bases.
+	var record *FASTARecord
+	var sequence strings.Builder
+	//Add the record being read to the records, or to the records skipped if it holds a character that is not a DNA base.
+	finishRecord := func() {
+		if record == nil {
+			return
+		}
+		record.Sequence = sequence.String()
+		if !isDNASequence(record.Sequence) {
+			skipped = append(skipped, record.Name)
+		} else {
+			records = append(records, *record)
+		}
+		sequence.Reset()
+	}
+
+	scanner := bufio.NewScanner(file)
+	scanner.Buffer(nil, maxFASTALineLength)
+	for lineNumber := 1; scanner.Scan(); lineNumber++ {
+		line := strings.TrimSpace(scanner.Text())
+		switch {
+		case line == "":
+			continue
+		case strings.HasPrefix(line, ">"):
+			finishRecord()
+			header := strings.SplitN(strings.TrimSpace(line[1:]), " ", 2)
+			record = &FASTARecord{Name: header[0]}
+			if len(header) == 2 {
+				record.Description = strings.TrimSpace(header[1])
+			}
+		case record == nil:
+			return nil, nil, fmt.Errorf("%s: line %d: sequence found before a > header line", fL, lineNumber)
+		default:
+			sequence.WriteString(strings.ToUpper(line))
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, nil, fmt.Errorf("%s: %w", fL, err)
+	}
+	finishRecord()
+
+	return
+}
+
+//LoadFASTADictionary reads in a FASTA file and creates a dictionary of its sequences, records holding anything other than DNA bases are skipped as in ReadFASTAFile.
+//INPUTS: filelocation (string)
+//OUTPUT: the loaded dictionary (*WordDictionary), names of the records skipped ([]string), error reading the file (error)
+func LoadFASTADictionary(fL string) (d *WordDictionary, skipped []string, err error) {
+	records, skipped, err := ReadFASTAFile(fL)
+	if err != nil {
+		return nil, nil, err
+	}
+	sequences := make([]string, len(records))
+	for i, record := range records {
+		sequences[i] = record.Sequence
+	}
+	return NewWordDictionary(sequences), skipped, nil
+}
+
+//DNAMutationPath uses the A* Graphing Algorythm to find the lowest cost series of single base mutations between two sequences of the same length,
+//where every sequence along the way is in the dictionary.
+//The sequences are read in upper case as ReadFASTAFile does, no path is found if either holds anything other than A, C, G or T.
+//INPUTS: start sequence, end sequence (strings), cost of each base change (*SubstitutionCostMatrix) (**If every change is to cost 1 enter nil**)
+//OUTPUT: the mutation path found (DNAMutationPath)
+func (d *WordDictionary) DNAMutationPath(sS, eS string, m *SubstitutionCostMatrix) DNAMutationPath {
+	result := DNAMutationPath{Sequences: []string{}, Mutations: []Mutation{}}
+	sS, eS = strings.ToUpper(sS), strings.ToUpper(eS)
+	if !isDNASequence(sS) || !isDNASequence(eS) {
+		return result
+	}
+	result.PathFound, result.Sequences, result.Cost = d.ladderBetween(sS, eS, SearchOptions{Substitutions: m})
+	for i := 1; i < len(result.Sequences); i++ {
+		result.Mutations = append(result.Mutations, mutationsBetween(result.Sequences[i-1], result.Sequences[i])...)
+	}
+	return result
+}
+
+//String returns the mutation in the usual notation, for example A12G.
+func (m Mutation) String() string {
+	return string(m.From) + strconv.Itoa(m.Position) + string(m.To)
+}
+
+//Check that a sequence only holds DNA bases (in upper case).
+func isDNASequence(sequence string) bool {
+	return strings.IndexFunc(sequence, func(base rune) bool { return !strings.ContainsRune(DNAAlphabet, base) }) == -1
+}
+
+//Find every base that is different between two sequences of the same length.
+func mutationsBetween(from, to string) []Mutation {
+	mutations := make([]Mutation, 0)
+	for i := 0; i < len(from); i++ {
+		if from[i] != to[i] {
+			mutations = append(mutations, Mutation{Position: i + 1, From: from[i], To: to[i]})
+		}
+	}
+	return mutations
+}
@@ -0,0 +1,15 @@
+package wordPathAnalyser
+
+//Structs used to hold the mocked input.
+type dnaMutationPathMockInput struct {
+	StartSequence, EndSequence string
+	Costs                      *SubstitutionCostMatrix
+	Result                     DNAMutationPath
+}
+
+type readFASTAMockInput struct {
+	File    string
+	Records []FASTARecord
+	Skipped []string
+	Error   string
+}
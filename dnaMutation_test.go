@@ -0,0 +1,127 @@
+package wordPathAnalyser
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+//Test that every record of a FASTA file is read with its sequence joined and in upper case, records that are not DNA bases are skipped.
+func TestReadFASTAFile(t *testing.T) {
+	fmt.Println("Testing FASTA file reader method: 'ReadFASTAFile'....")
+
+	//Arrange
+	headerlessFile := filepath.Join(t.TempDir(), "headerless.fasta")
+	if err := os.WriteFile(headerlessFile, []byte("AAAA\n>start\nAAAA"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	//A sequence on one line longer than a bufio.Scanner's default 64KB buffer.
+	longSequence := strings.Repeat("ACGT", 32*1024)
+	longLineFile := filepath.Join(t.TempDir(), "longLine.fasta")
+	if err := os.WriteFile(longLineFile, []byte(">genome\n"+longSequence+"\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	missingFile := filepath.Join(t.TempDir(), "missing.fasta")
+	testInputs := []readFASTAMockInput{
+		{File: "./testInputDNA.fasta",
+			Records: []FASTARecord{
+				{Name: "start", Description: "reference sequence", Sequence: "AAAA"},
+				{Name: "detour1", Sequence: "AAGA"},
+				{Name: "detour2", Sequence: "GAGA"},
+				{Name: "detour3", Sequence: "GGGA"},
+				{Name: "direct1", Description: "transversion", Sequence: "CAAA"},
+				{Name: "direct2", Sequence: "CGAA"},
+				{Name: "end", Sequence: "GGAA"},
+				{Name: "longer", Sequence: "ACGTA"},
+			},
+			Skipped: []string{"ambiguous", "iupac"}},
+		{File: headerlessFile, Records: nil, Skipped: nil,
+			Error: headerlessFile + ": line 1: sequence found before a > header line"},
+		{File: longLineFile, Records: []FASTARecord{{Name: "genome", Sequence: longSequence}}, Skipped: []string{}},
+		{File: missingFile, Records: nil, Skipped: nil,
+			Error: "open " + missingFile + ": no such file or directory"},
+	}
+
+	for i, input := range testInputs {
+		fmt.Print("Test ", i+1, " of ", len(testInputs))
+		//Act
+		records, skipped, err := ReadFASTAFile(input.File)
+		errorText := ""
+		if err != nil {
+			errorText = err.Error()
+		}
+
+		//Assert
+		if fmt.Sprint(input.Records) != fmt.Sprint(records) || fmt.Sprint(input.Skipped) != fmt.Sprint(skipped) || input.Error != errorText {
+			t.Error(
+				"Test number ", i+1, "\n",
+				"Given the inputs:\n",
+				"file = ", input.File, "\n",
+				"Expected results to be:\n",
+				input.Records, " ", input.Skipped, " ", input.Error, "\n",
+				"Actual results were:\n",
+				records, " ", skipped, " ", errorText, "\n",
+			)
+			fmt.Println(" - failed.")
+		} else {
+			fmt.Println(" - passed.")
+		}
+	}
+	fmt.Print("\n")
+}
+
+//Test that the lowest cost mutation path is found and each mutation is listed.
+func TestWordDictionaryDNAMutationPath(t *testing.T) {
+	fmt.Println("Testing dictionary DNA mutation method: 'DNAMutationPath'....")
+
+	//Arrange
+	dictionary, _, err := LoadFASTADictionary("./testInputDNA.fasta")
+	if err != nil {
+		t.Fatal(err)
+	}
+	transitions := TransitionTransversionCostMatrix(1, 2)
+	testInputs := []dnaMutationPathMockInput{
+		{StartSequence: "AAAA", EndSequence: "GGAA", Costs: transitions,
+			Result: DNAMutationPath{PathFound: true, Sequences: []string{"AAAA", "AAGA", "GAGA", "GGGA", "GGAA"}, Cost: 4,
+				Mutations: []Mutation{{3, 'A', 'G'}, {1, 'A', 'G'}, {2, 'A', 'G'}, {3, 'G', 'A'}}}},
+		{StartSequence: "AAAA", EndSequence: "GGAA", Costs: nil,
+			Result: DNAMutationPath{PathFound: true, Sequences: []string{"AAAA", "CAAA", "CGAA", "GGAA"}, Cost: 3,
+				Mutations: []Mutation{{1, 'A', 'C'}, {2, 'A', 'G'}, {1, 'C', 'G'}}}},
+		{StartSequence: "AAAA", EndSequence: "GGAT", Costs: transitions,
+			Result: DNAMutationPath{PathFound: true, Sequences: []string{"AAAA", "AAGA", "GAGA", "GGGA", "GGAA", "GGAT"}, Cost: 6,
+				Mutations: []Mutation{{3, 'A', 'G'}, {1, 'A', 'G'}, {2, 'A', 'G'}, {3, 'G', 'A'}, {4, 'A', 'T'}}}},
+		{StartSequence: "AAAA", EndSequence: "ACGTA", Costs: transitions,
+			Result: DNAMutationPath{PathFound: false, Sequences: []string{}, Mutations: []Mutation{}}},
+		{StartSequence: "aaaa", EndSequence: "ggAA", Costs: nil,
+			Result: DNAMutationPath{PathFound: true, Sequences: []string{"AAAA", "CAAA", "CGAA", "GGAA"}, Cost: 3,
+				Mutations: []Mutation{{1, 'A', 'C'}, {2, 'A', 'G'}, {1, 'C', 'G'}}}},
+		{StartSequence: "AANA", EndSequence: "GGAA", Costs: nil,
+			Result: DNAMutationPath{PathFound: false, Sequences: []string{}, Mutations: []Mutation{}}},
+	}
+
+	for i, input := range testInputs {
+		fmt.Print("Test ", i+1, " of ", len(testInputs))
+		//Act
+		result := dictionary.DNAMutationPath(input.StartSequence, input.EndSequence, input.Costs)
+
+		//Assert
+		if fmt.Sprint(input.Result) != fmt.Sprint(result) {
+			t.Error(
+				"Test number ", i+1, "\n",
+				"Given the inputs:\n",
+				"start sequence = ", input.StartSequence, "\n",
+				"end sequence = ", input.EndSequence, "\n",
+				"Expected result to be:\n",
+				input.Result, "\n",
+				"Actual result was:\n",
+				result, "\n",
+			)
+			fmt.Println(" - failed.")
+		} else {
+			fmt.Println(" - passed.")
+		}
+	}
+	fmt.Print("\n")
+}
@@ -0,0 +1,136 @@
+package wordPathAnalyser
+
+import "sort"
+
+//WordLengthDiameter holds the hardest ladders found between words of a single length.
+type WordLengthDiameter struct {
+	//Length of the words analysed.
+	WordLength int
+	//Number of steps in the longest shortest ladder (0 if no two words of this length are connected).
+	Steps int
+	//Every start/end word pair whose shortest ladder takes Steps steps, each pair is only listed once (alphabetical order).
+	Pairs [][2]string
+	//True when only a sample of start words was analysed, Steps is then a lower bound of the real diameter.
+	Estimated bool
+}
+
+//GraphDiameterFile finds the longest shortest ladder (the graph diameter) for each word length in the file.
+//A breadth first search is run from every word (or a sample of words) across GOMAXPROCS goroutines.
+//INPUTS: filelocation, delimiter (strings) (**If delimiter is not to be used enter ""**), sampleSize (int) (**Enter 0 to search from every word**)
+//OUTPUT: diameter for each word length sorted by word length ([]WordLengthDiameter)
+func GraphDiameterFile(fL, dL string, sS int) []WordLengthDiameter {
+	return graphDiameters(newWordGraphs(readWordList(fL, dL)), sS)
+}
+
+//Calculate the diameter of each word graph, when sampleSize is above 0 (and less than the number of words) the diameter is estimated.
+func graphDiameters(graphs []*wordGraph, sampleSize int) []WordLengthDiameter {
+	results := make([]WordLengthDiameter, 0, len(graphs))
+
+	for _, graph := range graphs {
+		result := WordLengthDiameter{WordLength: graph.WordLength, Pairs: [][2]string{}}
+
+		//Every word is used as a start word unless a sample is requested.
+		sources := make([]int, len(graph.Words))
+		for i := range sources {
+			sources[i] = i
+		}
+		if sampleSize > 0 && sampleSize < len(graph.Words) {
+			result.Estimated = true
+			sources = sampleSources(graph, sampleSize)
+		}
+
+		result.Steps, result.Pairs = furthestPairs(graph, sources)
+		results = append(results, result)
+	}
+
+	return results
+}
+
+//Find the largest distance from any of the source words and every pair of words that are that distance apart.
+func furthestPairs(graph *wordGraph, sources []int) (steps int, pairs [][2]string) {
+	//Furthest distance and the words at that distance for each source (indexed the same as sources so the merge is deterministic).
+	furthestSteps := make([]int, len(sources))
+	furthestWords := make([][]int, len(sources))
+
+	forEachIDConcurrently(len(sources), func(i int) {
+		for word, distance := range graph.distancesFrom(sources[i]) {
+			if distance > furthestSteps[i] {
+				furthestSteps[i] = distance
+				furthestWords[i] = furthestWords[i][:0]
+			}
+			if distance == furthestSteps[i] && distance > 0 {
+				furthestWords[i] = append(furthestWords[i], word)
+			}
+		}
+	})
+
+	for _, s := range furthestSteps {
+		if s > steps {
+			steps = s
+		}
+	}
+
+	//Set of pairs already added, used as the same pair is found from both of its words.
+	seen := make(map[[2]string]bool)
+	pairs = [][2]string{}
+	for i, source := range sources {
+		if steps == 0 || furthestSteps[i] != steps {
+			continue
+		}
+		for _, word := range furthestWords[i] {
+			pair := [2]string{graph.Words[source], graph.Words[word]}
+			if pair[1] < pair[0] {
+				pair[0], pair[1] = pair[1], pair[0]
+			}
+			if !seen[pair] {
+				seen[pair] = true
+				pairs = append(pairs, pair)
+			}
+		}
+	}
+	sort.Slice(pairs, func(i, j int) bool {
+		if pairs[i][0] != pairs[j][0] {
+			return pairs[i][0] < pairs[j][0]
+		}
+		return pairs[i][1] < pairs[j][1]
+	})
+
+	return
+}
+
+//Choose evenly spaced start words from the graph, each one is followed by the word furthest from it.
+//Starting a second search from the furthest word (a double sweep) gives a much closer estimate of the diameter.
+func sampleSources(graph *wordGraph, sampleSize int) []int {
+	//Gap between each sampled word ID.
+	stride := len(graph.Words) / sampleSize
+	sources := make([]int, sampleSize)
+	for i := range sources {
+		sources[i] = i * stride
+	}
+
+	//The word furthest from each sampled word.
+	furthest := make([]int, sampleSize)
+	forEachIDConcurrently(sampleSize, func(i int) {
+		furthest[i] = sources[i]
+		distances := graph.distancesFrom(sources[i])
+		for word, distance := range distances {
+			if distance > distances[furthest[i]] {
+				furthest[i] = word
+			}
+		}
+	})
+
+	//Add each furthest word, skipping those already in the sample.
+	inSample := make(map[int]bool, sampleSize*2)
+	for _, source := range sources {
+		inSample[source] = true
+	}
+	for _, word := range furthest {
+		if !inSample[word] {
+			inSample[word] = true
+			sources = append(sources, word)
+		}
+	}
+
+	return sources
+}
@@ -0,0 +1,8 @@
+package wordPathAnalyser
+
+//Structs used to hold the mocked input.
+type graphDiameterMockInput struct {
+	FileLocation, Delimiter string
+	SampleSize              int
+	Result                  []WordLengthDiameter
+}
@@ -0,0 +1,60 @@
+package wordPathAnalyser
+
+import (
+	"fmt"
+	"testing"
+)
+
+//Test that the longest shortest ladder is found for each word length in a file.
+func TestGraphDiameterFile(t *testing.T) {
+	fmt.Println("Testing graph diameter method: 'GraphDiameterFile'....")
+
+	//Arrange
+	testInputs := []graphDiameterMockInput{
+		{FileLocation: "./testInputGraph.txt",
+			Delimiter:  "",
+			SampleSize: 0,
+			Result: []WordLengthDiameter{
+				{WordLength: 3, Steps: 3, Pairs: [][2]string{{"cat", "dog"}}},
+				{WordLength: 4, Steps: 6, Pairs: [][2]string{{"bolt", "warm"}}},
+			}},
+		{FileLocation: "./testInputGraph.txt",
+			Delimiter:  "",
+			SampleSize: 2,
+			Result: []WordLengthDiameter{
+				{WordLength: 3, Steps: 3, Pairs: [][2]string{{"cat", "dog"}}, Estimated: true},
+				{WordLength: 4, Steps: 6, Pairs: [][2]string{{"bolt", "warm"}}, Estimated: true},
+			}},
+		{FileLocation: "./testInputDelimited.txt",
+			Delimiter:  ",",
+			SampleSize: 0,
+			Result: []WordLengthDiameter{
+				{WordLength: 4, Steps: 3, Pairs: [][2]string{{"most", "test"}}},
+			}},
+	}
+
+	for i, input := range testInputs {
+		fmt.Print("Test ", i+1, " of ", len(testInputs))
+		//Act
+		result := GraphDiameterFile(input.FileLocation, input.Delimiter, input.SampleSize)
+
+		//Assert
+		if fmt.Sprint(input.Result) != fmt.Sprint(result) {
+			t.Error(
+				"Test number ", i+1, "\n",
+				"Given the inputs:\n",
+				"file location = ", input.FileLocation, "\n",
+				"delimiter = ", input.Delimiter, "\n",
+				"sample size = ", input.SampleSize, "\n",
+				"Expected result to be:\n",
+				"Diameters = ", input.Result, "\n",
+				"Actual result was:\n",
+				"Diameters = ", result, "\n",
+			)
+			fmt.Println(" - failed.")
+		} else {
+			fmt.Println(" - passed.")
+		}
+	}
+	fmt.Print("\n")
+}
@@ -0,0 +1,116 @@
+package wordPathAnalyser
+
+import "math"
+
+//Weights used to combine the difficulty factors into a single score.
+const (
+	difficultySolutionsWeight = 2.0
+	difficultyBranchingWeight = 0.5
+	difficultyRarityWeight    = 3.0
+	difficultyDeadEndWeight   = 0.5
+)
+
+//LadderDifficulty holds the difficulty score for a start and end word pair and the factors it was calculated from.
+type LadderDifficulty struct {
+	StartWord, EndWord string
+	//Number of steps in the shortest ladder (-1 if there is no ladder, all other values are then 0).
+	PathSteps int
+	//Number of distinct shortest ladders.
+	OptimalSolutions int
+	//Average number of neighbours of each word on the solution before the end word.
+	BranchingFactor float64
+	//Average rarity of the words between the start and end word on the solution, from 0 (most common) to 1 (unknown).
+	Rarity float64
+	//Number of words within 2 steps of the start word that have more letters in common with the end word than the start word does but are not on any shortest ladder.
+	DeadEnds int
+	//Amount each factor adds to the score.
+	Parts LadderDifficultyParts
+	//Total difficulty score (the sum of the parts).
+	Score float64
+	//The solution the branching factor and rarity were measured on (from start word to end word).
+	Solution []string
+}
+
+//LadderDifficultyParts holds the amount each factor adds to a difficulty score.
+type LadderDifficultyParts struct {
+	//One point per step.
+	PathSteps float64
+	//2 points for a unique solution, falling as the number of solutions grows.
+	OptimalSolutions float64
+	//Half a point per neighbour.
+	BranchingFactor float64
+	//Up to 3 points for rare words.
+	Rarity float64
+	//Half a point per dead end.
+	DeadEnds float64
+}
+
+//LadderDifficulty scores how hard it is to find a ladder between two dictionary words.
+//INPUTS: startword, endword (strings) (**both words must be in the dictionary**)
+//OUTPUT: the difficulty score and its breakdown (LadderDifficulty)
+func (d *WordDictionary) LadderDifficulty(sW, eW string) LadderDifficulty {
+	result := LadderDifficulty{StartWord: sW, EndWord: eW, PathSteps: -1, Solution: []string{}}
+
+	graph := d.wordGraph(len(sW))
+	start, end := indexOf(graph.Words, sW), indexOf(graph.Words, eW)
+	if len(sW) != len(eW) || start == -1 || end == -1 {
+		return result
+	}
+	fromStart, ladderCounts := graph.ladderCountsFrom(start)
+	if fromStart[end] == -1 {
+		return result
+	}
+	fromEnd := graph.distancesFrom(end)
+
+	result.PathSteps = fromStart[end]
+	result.OptimalSolutions = ladderCounts[end]
+
+	//Measure the branching and rarity along the A* solution.
+	_, resultPath := d.AStarAnalyse(sW, eW)
+	result.Solution = reverseWords(resultPath)
+	for _, word := range result.Solution[:len(result.Solution)-1] {
+		result.BranchingFactor += float64(len(graph.Edges[indexOf(graph.Words, word)]))
+	}
+	if result.PathSteps > 0 {
+		result.BranchingFactor /= float64(result.PathSteps)
+	}
+	//A ladder from a word to itself is only the one word, so has no words between the start and end word.
+	if len(result.Solution) > 2 {
+		intermediate := result.Solution[1 : len(result.Solution)-1]
+		for _, word := range intermediate {
+			result.Rarity += d.rarity(word)
+		}
+		result.Rarity /= float64(len(intermediate))
+	}
+
+	//Count the tempting words near the start that do not lead to a shortest ladder.
+	startCost := calculateNodeCost(sW, eW)
+	for id, distance := range fromStart {
+		if distance < 1 || distance > 2 || id == end {
+			continue
+		}
+		if calculateNodeCost(graph.Words[id], eW) < startCost && fromEnd[id]+distance != result.PathSteps {
+			result.DeadEnds++
+		}
+	}
+
+	result.Parts = LadderDifficultyParts{
+		PathSteps:        float64(result.PathSteps),
+		OptimalSolutions: difficultySolutionsWeight / float64(result.OptimalSolutions),
+		BranchingFactor:  difficultyBranchingWeight * result.BranchingFactor,
+		Rarity:           difficultyRarityWeight * result.Rarity,
+		DeadEnds:         difficultyDeadEndWeight * float64(result.DeadEnds),
+	}
+	result.Score = result.Parts.PathSteps + result.Parts.OptimalSolutions + result.Parts.BranchingFactor + result.Parts.Rarity + result.Parts.DeadEnds
+
+	return result
+}
+
+//Calculate how rare a word is compared with the most common word in the dictionary, from 0 (most common) to 1 (unknown).
+//A log scale is used as word frequencies fall away very quickly.
+func (d *WordDictionary) rarity(word string) float64 {
+	if d.maxFrequency == 0 {
+		return 1
+	}
+	return 1 - math.Log1p(float64(d.Frequency(word)))/math.Log1p(float64(d.maxFrequency))
+}
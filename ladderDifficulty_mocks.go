@@ -0,0 +1,8 @@
+package wordPathAnalyser
+
+//Structs used to hold the mocked input.
+type ladderDifficultyMockInput struct {
+	StartWord, EndWord string
+	Frequencies        map[string]int
+	Result             LadderDifficulty
+}
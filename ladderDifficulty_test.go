@@ -0,0 +1,94 @@
+package wordPathAnalyser
+
+import (
+	"fmt"
+	"math"
+	"testing"
+)
+
+//Test that each difficulty factor is measured and combined into the score.
+func TestWordDictionaryLadderDifficulty(t *testing.T) {
+	fmt.Println("Testing ladder difficulty method: 'LadderDifficulty'....")
+
+	//Arrange
+	frequencies := map[string]int{"cold": 100, "cord": 100, "word": 10, "worm": 1, "warm": 90}
+	testInputs := []ladderDifficultyMockInput{
+		{StartWord: "cold", EndWord: "warm",
+			Result: LadderDifficulty{StartWord: "cold", EndWord: "warm",
+				PathSteps: 4, OptimalSolutions: 3, BranchingFactor: 3, Rarity: 1, DeadEnds: 1,
+				Parts: LadderDifficultyParts{PathSteps: 4, OptimalSolutions: 0.667, BranchingFactor: 1.5, Rarity: 3, DeadEnds: 0.5},
+				Score: 9.667, Solution: []string{"cold", "cord", "word", "worm", "warm"}}},
+		{StartWord: "cold", EndWord: "warm", Frequencies: frequencies,
+			Result: LadderDifficulty{StartWord: "cold", EndWord: "warm",
+				PathSteps: 4, OptimalSolutions: 3, BranchingFactor: 3, Rarity: 0.443, DeadEnds: 1,
+				Parts: LadderDifficultyParts{PathSteps: 4, OptimalSolutions: 0.667, BranchingFactor: 1.5, Rarity: 1.330, DeadEnds: 0.5},
+				Score: 7.997, Solution: []string{"cold", "cord", "word", "worm", "warm"}}},
+		{StartWord: "cat", EndWord: "dog",
+			Result: LadderDifficulty{StartWord: "cat", EndWord: "dog",
+				PathSteps: 3, OptimalSolutions: 2, BranchingFactor: 2, Rarity: 1, DeadEnds: 0,
+				Parts: LadderDifficultyParts{PathSteps: 3, OptimalSolutions: 1, BranchingFactor: 1, Rarity: 3, DeadEnds: 0},
+				Score: 8, Solution: []string{"cat", "cot", "cog", "dog"}}},
+		{StartWord: "cold", EndWord: "cord", Frequencies: frequencies,
+			Result: LadderDifficulty{StartWord: "cold", EndWord: "cord",
+				PathSteps: 1, OptimalSolutions: 1, BranchingFactor: 3, Rarity: 0, DeadEnds: 0,
+				Parts: LadderDifficultyParts{PathSteps: 1, OptimalSolutions: 2, BranchingFactor: 1.5, Rarity: 0, DeadEnds: 0},
+				Score: 4.5, Solution: []string{"cold", "cord"}}},
+		{StartWord: "cat", EndWord: "cold",
+			Result: LadderDifficulty{StartWord: "cat", EndWord: "cold", PathSteps: -1, Solution: []string{}}},
+		{StartWord: "cold", EndWord: "warm", Frequencies: map[string]int{"cold": -5, "warm": -1},
+			Result: LadderDifficulty{StartWord: "cold", EndWord: "warm",
+				PathSteps: 4, OptimalSolutions: 3, BranchingFactor: 3, Rarity: 1, DeadEnds: 1,
+				Parts: LadderDifficultyParts{PathSteps: 4, OptimalSolutions: 0.667, BranchingFactor: 1.5, Rarity: 3, DeadEnds: 0.5},
+				Score: 9.667, Solution: []string{"cold", "cord", "word", "worm", "warm"}}},
+		{StartWord: "cold", EndWord: "warm", Frequencies: map[string]int{"cold": 100, "cord": 100, "word": -5, "worm": -1, "warm": 90},
+			Result: LadderDifficulty{StartWord: "cold", EndWord: "warm",
+				PathSteps: 4, OptimalSolutions: 3, BranchingFactor: 3, Rarity: 0.667, DeadEnds: 1,
+				Parts: LadderDifficultyParts{PathSteps: 4, OptimalSolutions: 0.667, BranchingFactor: 1.5, Rarity: 2, DeadEnds: 0.5},
+				Score: 8.667, Solution: []string{"cold", "cord", "word", "worm", "warm"}}},
+		{StartWord: "cold", EndWord: "cold",
+			Result: LadderDifficulty{StartWord: "cold", EndWord: "cold",
+				PathSteps: 0, OptimalSolutions: 1, BranchingFactor: 0, Rarity: 0, DeadEnds: 0,
+				Parts: LadderDifficultyParts{PathSteps: 0, OptimalSolutions: 2, BranchingFactor: 0, Rarity: 0, DeadEnds: 0},
+				Score: 2, Solution: []string{"cold"}}},
+	}
+
+	for i, input := range testInputs {
+		fmt.Print("Test ", i+1, " of ", len(testInputs))
+		dictionary := LoadWordDictionary("./testInputGraph.txt", "")
+		dictionary.SetWordFrequencies(input.Frequencies)
+		//Act
+		result := dictionary.LadderDifficulty(input.StartWord, input.EndWord)
+
+		//Assert
+		if fmt.Sprint(input.Result) != fmt.Sprint(roundDifficulty(result)) {
+			t.Error(
+				"Test number ", i+1, "\n",
+				"Given the inputs:\n",
+				"start word = ", input.StartWord, "\n",
+				"end word = ", input.EndWord, "\n",
+				"frequencies = ", input.Frequencies, "\n",
+				"Expected result to be:\n",
+				"Difficulty = ", input.Result, "\n",
+				"Actual result was:\n",
+				"Difficulty = ", result, "\n",
+			)
+			fmt.Println(" - failed.")
+		} else {
+			fmt.Println(" - passed.")
+		}
+	}
+	fmt.Print("\n")
+}
+
+//-----------INTERNAL FUNCTIONS-----------\\
+//Round every score in a difficulty result to 3 decimal places so it can be compared with the expected result.
+func roundDifficulty(d LadderDifficulty) LadderDifficulty {
+	round := func(f float64) float64 { return math.Round(f*1000) / 1000 }
+	d.BranchingFactor = round(d.BranchingFactor)
+	d.Rarity = round(d.Rarity)
+	d.Parts.OptimalSolutions = round(d.Parts.OptimalSolutions)
+	d.Parts.BranchingFactor = round(d.Parts.BranchingFactor)
+	d.Parts.Rarity = round(d.Parts.Rarity)
+	d.Score = round(d.Score)
+	return d
+}
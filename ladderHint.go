@@ -0,0 +1,102 @@
+package wordPathAnalyser
+
+//HintLevel is used to choose how much of the next word a hint reveals.
+type HintLevel int
+
+const (
+	//HintPosition reveals the position of the letter to change.
+	HintPosition HintLevel = iota + 1
+	//HintLetter reveals the position and the letter to change it to.
+	HintLetter
+	//HintWord reveals the whole of the next word.
+	HintWord
+)
+
+//LadderHint holds a hint for the next word of a partially solved ladder.
+type LadderHint struct {
+	Level HintLevel
+	//Number of steps left to the target word along a shortest continuation (-1 if the target cannot be reached).
+	StepsRemaining int
+	//Position (from index 0) of the letter to change in the last word (-1 if there is no next word).
+	Position int
+	//Letter to change to (only set from HintLetter).
+	Letter string
+	//The next word (only set for HintWord).
+	Word string
+}
+
+//BestNextWords finds every word that can be played next on a shortest continuation of a partial ladder.
+//Words already used in the partial ladder are not used again, an A* search is run from each possible next word.
+//INPUTS: partial ladder from start word to last word played ([]string), target word (string)
+//OUTPUT: best next words in dictionary order ([]string), steps left to the target from the last word (int) (-1 if it cannot be reached)
+func (d *WordDictionary) BestNextWords(partial []string, target string) (nextWords []string, stepsRemaining int) {
+	nextWords = []string{}
+	stepsRemaining = -1
+	if len(partial) == 0 || len(partial[len(partial)-1]) != len(target) {
+		return
+	}
+	//The word the continuation starts from.
+	lastWord := partial[len(partial)-1]
+	if lastWord == target {
+		stepsRemaining = 0
+		return
+	}
+
+	for _, word := range d.Neighbours(lastWord) {
+		if indexOf(partial, word) != -1 {
+			continue
+		}
+
+		//Number of steps from the last word to the target when this word is played next.
+		steps := 1
+		if word != target {
+			pathFound, resultPath := aStarAnalyse(word, target, d.searchNodes(len(word), append([]string{word, target}, partial...)...))
+			if !pathFound {
+				continue
+			}
+			steps += len(resultPath) - 1
+		}
+
+		if stepsRemaining == -1 || steps < stepsRemaining {
+			stepsRemaining = steps
+			nextWords = nextWords[:0]
+		}
+		if steps == stepsRemaining {
+			nextWords = append(nextWords, word)
+		}
+	}
+
+	return
+}
+
+//LadderHint gives a graded hint for the next word of a partially solved ladder.
+//The hint is for the first of the best next words (see BestNextWords).
+//INPUTS: partial ladder from start word to last word played ([]string), target word (string), hint level (HintLevel)
+//OUTPUT: hint revealing as much of the next word as the level allows (LadderHint)
+func (d *WordDictionary) LadderHint(partial []string, target string, level HintLevel) LadderHint {
+	hint := LadderHint{Level: level, Position: -1}
+
+	nextWords, stepsRemaining := d.BestNextWords(partial, target)
+	hint.StepsRemaining = stepsRemaining
+	if len(nextWords) == 0 {
+		return hint
+	}
+
+	//The last word played and the word the hint is for.
+	lastWord := partial[len(partial)-1]
+	nextWord := nextWords[0]
+	for i := 0; i < len(nextWord); i++ {
+		if lastWord[i] != nextWord[i] {
+			hint.Position = i
+			break
+		}
+	}
+	if level >= HintLetter {
+		hint.Letter = nextWord[hint.Position : hint.Position+1]
+	}
+	if level >= HintWord {
+		hint.Word = nextWord
+	}
+
+	return hint
+}
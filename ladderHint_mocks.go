@@ -0,0 +1,15 @@
+package wordPathAnalyser
+
+//Structs used to hold the mocked input.
+type ladderBestNextWordsMockInput struct {
+	Partial              []string
+	Target               string
+	ResultNextWords      []string
+	ResultStepsRemaining int
+}
+type ladderHintMockInput struct {
+	Partial []string
+	Target  string
+	Level   HintLevel
+	Result  LadderHint
+}
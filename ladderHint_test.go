@@ -0,0 +1,99 @@
+package wordPathAnalyser
+
+import (
+	"fmt"
+	"testing"
+)
+
+//Test that every best next word is found and that used words are not suggested.
+func TestWordDictionaryBestNextWords(t *testing.T) {
+	fmt.Println("Testing best next words method: 'BestNextWords'....")
+
+	//Arrange
+	dictionary := LoadWordDictionary("./testInputGraph.txt", "")
+	testInputs := []ladderBestNextWordsMockInput{
+		{Partial: []string{"cold"}, Target: "warm",
+			ResultNextWords: []string{"cord"}, ResultStepsRemaining: 4},
+		{Partial: []string{"cold", "cord"}, Target: "warm",
+			ResultNextWords: []string{"card", "word"}, ResultStepsRemaining: 3},
+		{Partial: []string{"cold", "cord", "word"}, Target: "card",
+			ResultNextWords: []string{"ward"}, ResultStepsRemaining: 2},
+		{Partial: []string{"cold", "cord", "card", "ward"}, Target: "warm",
+			ResultNextWords: []string{"warm"}, ResultStepsRemaining: 1},
+		{Partial: []string{"cold", "cord", "card", "ward", "warm"}, Target: "warm",
+			ResultNextWords: []string{}, ResultStepsRemaining: 0},
+		{Partial: []string{"cat"}, Target: "cold",
+			ResultNextWords: []string{}, ResultStepsRemaining: -1},
+		{Partial: []string{}, Target: "cold",
+			ResultNextWords: []string{}, ResultStepsRemaining: -1},
+	}
+
+	for i, input := range testInputs {
+		fmt.Print("Test ", i+1, " of ", len(testInputs))
+		//Act
+		nextWords, stepsRemaining := dictionary.BestNextWords(input.Partial, input.Target)
+
+		//Assert
+		if !doArraysMatch(input.ResultNextWords, nextWords) || input.ResultStepsRemaining != stepsRemaining {
+			t.Error(
+				"Test number ", i+1, "\n",
+				"Given the inputs:\n",
+				"partial ladder = ", input.Partial, "\n",
+				"target = ", input.Target, "\n",
+				"Expected result to be:\n",
+				"Next Words = ", input.ResultNextWords, "\n",
+				"Steps Remaining = ", input.ResultStepsRemaining, "\n",
+				"Actual result was:\n",
+				"Next Words = ", nextWords, "\n",
+				"Steps Remaining = ", stepsRemaining, "\n",
+			)
+			fmt.Println(" - failed.")
+		} else {
+			fmt.Println(" - passed.")
+		}
+	}
+	fmt.Print("\n")
+}
+
+//Test that each hint level reveals the right amount of the next word.
+func TestWordDictionaryLadderHint(t *testing.T) {
+	fmt.Println("Testing ladder hint method: 'LadderHint'....")
+
+	//Arrange
+	dictionary := LoadWordDictionary("./testInputGraph.txt", "")
+	testInputs := []ladderHintMockInput{
+		{Partial: []string{"cold", "cord"}, Target: "warm", Level: HintPosition,
+			Result: LadderHint{Level: HintPosition, StepsRemaining: 3, Position: 1}},
+		{Partial: []string{"cold", "cord"}, Target: "warm", Level: HintLetter,
+			Result: LadderHint{Level: HintLetter, StepsRemaining: 3, Position: 1, Letter: "a"}},
+		{Partial: []string{"cold", "cord"}, Target: "warm", Level: HintWord,
+			Result: LadderHint{Level: HintWord, StepsRemaining: 3, Position: 1, Letter: "a", Word: "card"}},
+		{Partial: []string{"cat"}, Target: "cold", Level: HintWord,
+			Result: LadderHint{Level: HintWord, StepsRemaining: -1, Position: -1}},
+	}
+
+	for i, input := range testInputs {
+		fmt.Print("Test ", i+1, " of ", len(testInputs))
+		//Act
+		result := dictionary.LadderHint(input.Partial, input.Target, input.Level)
+
+		//Assert
+		if input.Result != result {
+			t.Error(
+				"Test number ", i+1, "\n",
+				"Given the inputs:\n",
+				"partial ladder = ", input.Partial, "\n",
+				"target = ", input.Target, "\n",
+				"level = ", input.Level, "\n",
+				"Expected result to be:\n",
+				"Hint = ", input.Result, "\n",
+				"Actual result was:\n",
+				"Hint = ", result, "\n",
+			)
+			fmt.Println(" - failed.")
+		} else {
+			fmt.Println(" - passed.")
+		}
+	}
+	fmt.Print("\n")
+}
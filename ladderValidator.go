@@ -0,0 +1,62 @@
+package wordPathAnalyser
+
+//LadderStepValidation holds the result of checking a single word of a ladder.
+type LadderStepValidation struct {
+	Word string
+	//True when the word is in the dictionary.
+	InDictionary bool
+	//True when the word is one allowed move from the previous word (always true for the first word).
+	ValidMove bool
+}
+
+//LadderValidation holds the result of checking a ladder submitted by a player.
+type LadderValidation struct {
+	//Result for each word in the ladder (in the order submitted).
+	Steps []LadderStepValidation
+	//True when every word is in the dictionary and every move is allowed.
+	Valid bool
+	//Number of steps in the ladder submitted.
+	LadderSteps int
+	//Number of steps in the shortest ladder between the first and last word (-1 if no ladder exists).
+	ShortestSteps int
+	//True when the ladder is valid and is as short as the shortest ladder.
+	Optimal bool
+}
+
+//ValidateLadder checks that each word of a ladder is in the dictionary and is one letter change from the previous word.
+//The ladder is also compared with the shortest ladder between its first and last word found by the A* search.
+//INPUTS: ladder from start word to end word ([]string), dictionary (*WordDictionary)
+//OUTPUT: validation result for the ladder and each of its steps (LadderValidation)
+func ValidateLadder(ladder []string, d *WordDictionary) LadderValidation {
+	result := LadderValidation{
+		Steps:         make([]LadderStepValidation, len(ladder)),
+		Valid:         len(ladder) != 0,
+		LadderSteps:   len(ladder) - 1,
+		ShortestSteps: -1,
+	}
+	if len(ladder) == 0 {
+		result.LadderSteps = 0
+		return result
+	}
+
+	for i, word := range ladder {
+		step := LadderStepValidation{Word: word, InDictionary: d.Contains(word), ValidMove: true}
+		if i > 0 {
+			step.ValidMove = isOneLetterChange(ladder[i-1], word)
+		}
+		result.Valid = result.Valid && step.InDictionary && step.ValidMove
+		result.Steps[i] = step
+	}
+
+	if pathFound, resultPath := d.AStarAnalyse(ladder[0], ladder[len(ladder)-1]); pathFound {
+		result.ShortestSteps = len(resultPath) - 1
+	}
+	result.Optimal = result.Valid && result.LadderSteps == result.ShortestSteps
+
+	return result
+}
+
+//Check if two words are the same length and differ by exactly one letter.
+func isOneLetterChange(s, e string) bool {
+	return len(s) == len(e) && calculateNodeCost(s, e) == 1
+}
@@ -0,0 +1,69 @@
+package wordPathAnalyser
+
+import (
+	"fmt"
+	"testing"
+)
+
+//Test that a ladder is checked against the dictionary and the shortest ladder.
+func TestValidateLadder(t *testing.T) {
+	fmt.Println("Testing ladder validation method: 'ValidateLadder'....")
+
+	//Arrange
+	dictionary := LoadWordDictionary("./testInputGraph.txt", "")
+	testInputs := []ladderValidatorMockInput{
+		{Ladder: []string{"cold", "cord", "card"},
+			Result: LadderValidation{
+				Steps:       []LadderStepValidation{{"cold", true, true}, {"cord", true, true}, {"card", true, true}},
+				Valid:       true,
+				LadderSteps: 2, ShortestSteps: 2, Optimal: true}},
+		{Ladder: []string{"cold", "cord", "core", "care", "card"},
+			Result: LadderValidation{
+				Steps:       []LadderStepValidation{{"cold", true, true}, {"cord", true, true}, {"core", true, true}, {"care", true, true}, {"card", true, true}},
+				Valid:       true,
+				LadderSteps: 4, ShortestSteps: 2, Optimal: false}},
+		{Ladder: []string{"cold", "card"},
+			Result: LadderValidation{
+				Steps:       []LadderStepValidation{{"cold", true, true}, {"card", true, false}},
+				Valid:       false,
+				LadderSteps: 1, ShortestSteps: 2, Optimal: false}},
+		{Ladder: []string{"cold", "hold", "bold"},
+			Result: LadderValidation{
+				Steps:       []LadderStepValidation{{"cold", true, true}, {"hold", false, true}, {"bold", true, true}},
+				Valid:       false,
+				LadderSteps: 2, ShortestSteps: 1, Optimal: false}},
+		{Ladder: []string{"cat", "cold"},
+			Result: LadderValidation{
+				Steps:       []LadderStepValidation{{"cat", true, true}, {"cold", true, false}},
+				Valid:       false,
+				LadderSteps: 1, ShortestSteps: -1, Optimal: false}},
+		{Ladder: []string{},
+			Result: LadderValidation{
+				Steps:       []LadderStepValidation{},
+				Valid:       false,
+				LadderSteps: 0, ShortestSteps: -1, Optimal: false}},
+	}
+
+	for i, input := range testInputs {
+		fmt.Print("Test ", i+1, " of ", len(testInputs))
+		//Act
+		result := ValidateLadder(input.Ladder, dictionary)
+
+		//Assert
+		if fmt.Sprint(input.Result) != fmt.Sprint(result) {
+			t.Error(
+				"Test number ", i+1, "\n",
+				"Given the inputs:\n",
+				"ladder = ", input.Ladder, "\n",
+				"Expected result to be:\n",
+				"Validation = ", input.Result, "\n",
+				"Actual result was:\n",
+				"Validation = ", result, "\n",
+			)
+			fmt.Println(" - failed.")
+		} else {
+			fmt.Println(" - passed.")
+		}
+	}
+	fmt.Print("\n")
+}
@@ -0,0 +1,149 @@
+package wordPathAnalyser
+
+import (
+	"bytes"
+	"log"
+)
+
+//MappedWordDictionary holds a word file mapped into memory, each word is kept as the offset of its first letter in the file.
+//Nothing else is created for a word until a search reaches it, so very large word lists can be loaded without a node for every word.
+//Empty words are ignored, duplicate words are kept.
+type MappedWordDictionary struct {
+	//Contents of the word file.
+	data []byte
+	//Offset of every word grouped by word length (in file order).
+	offsetsByLength map[int][]int
+	//Unmap the contents of the word file.
+	unmap func() error
+}
+
+//mappedSource is the sequenceSource of one search of a MappedWordDictionary, a node is only created for a word once the search finds it.
+type mappedSource struct {
+	dictionary *MappedWordDictionary
+	//The start word and the node of the end word, the end node is a child until it has been found.
+	start    []byte
+	endNode  *sequenceNode[byte]
+	foundEnd bool
+	//Nodes of the dictionary words found so far keyed by the offset of the word.
+	reached map[int]*sequenceNode[byte]
+	//Goroutines used to scan the words (nil if they are too few to split).
+	pool *scanPool
+}
+
+//LoadMappedWordDictionary maps a word file into memory and creates a dictionary from it, Close should be called once the dictionary is no longer used.
+//INPUTS: filelocation, delimiter (strings) (**If delimiter is not to be used enter ""**)
+//OUTPUT: the loaded dictionary (*MappedWordDictionary)
+func LoadMappedWordDictionary(fL, dL string) *MappedWordDictionary {
+	data, unmap, err := mapFile(fL)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	d := &MappedWordDictionary{data: data, offsetsByLength: make(map[int][]int), unmap: unmap}
+	for start := 0; start < len(data); {
+		end := bytes.IndexByte(data[start:], '\n')
+		if end == -1 {
+			end = len(data)
+		} else {
+			end += start
+		}
+		//A carriage return at the end of a line is not part of the line (as when the file is read a line at a time).
+		lineEnd := end
+		if lineEnd > start && data[lineEnd-1] == '\r' {
+			lineEnd--
+		}
+		d.addLine(start, lineEnd, dL)
+		start = end + 1
+	}
+
+	return d
+}
+
+//Close unmaps the word file, the dictionary and any words taken from it cannot be used afterwards.
+func (d *MappedWordDictionary) Close() error {
+	unmap := d.unmap
+	d.data, d.offsetsByLength, d.unmap = nil, nil, nil
+	if unmap == nil {
+		return nil
+	}
+	return unmap()
+}
+
+//Len returns the number of words in the dictionary.
+func (d *MappedWordDictionary) Len() int {
+	total := 0
+	for _, offsets := range d.offsetsByLength {
+		total += len(offsets)
+	}
+	return total
+}
+
+//AStarAnalyse uses the A* Graphing Algorythm to find the shortest path between two words of the same length using the words in the dictionary.
+//Search state is kept in a table of the words reached, so the memory used depends on how much of the dictionary is searched rather than its size.
+//INPUTS: startword, endword (strings)
+//OUTPUT: path found result (Boolean), path from end word to start word ([]string) (if no path is found emtpy array is returned)
+func (d *MappedWordDictionary) AStarAnalyse(sW, eW string) (foundResult bool, resultPath []string) {
+	start, end := []byte(sW), []byte(eW)
+	source := &mappedSource{dictionary: d, start: start, endNode: &sequenceNode[byte]{Sequence: end}, reached: make(map[int]*sequenceNode[byte]),
+		pool: newScanPool(len(d.offsetsByLength[len(sW)]))}
+	defer source.pool.close()
+
+	foundResult, sequencePath := aStarAnalyseSequences(start, end, source)
+
+	return foundResult, sequencePathWords(sequencePath)
+}
+
+//Add the words in a line of the file between the start and end offset, the line is split on the delimiter if there is one.
+func (d *MappedWordDictionary) addLine(start, end int, dL string) {
+	for dL != "" {
+		i := bytes.Index(d.data[start:end], []byte(dL))
+		if i == -1 {
+			break
+		}
+		d.addWord(start, start+i)
+		start += i + len(dL)
+	}
+	d.addWord(start, end)
+}
+
+//Add the word between the start and end offset, empty words are ignored.
+func (d *MappedWordDictionary) addWord(start, end int) {
+	if end > start {
+		d.offsetsByLength[end-start] = append(d.offsetsByLength[end-start], start)
+	}
+}
+
+//Find the children of a node that have not been found yet, every dictionary word that is 1 letter different (in file order) followed by the end word.
+//A node is created and added to the reached table for each child, copies of the start and end word in the dictionary are never children.
+//The words are scanned in blocks on the pool's goroutines, the reached table is only read until every block has been scanned.
+func (s *mappedSource) children(node *sequenceNode[byte]) []*sequenceNode[byte] {
+	childrenNodes := make([]*sequenceNode[byte], 0)
+	wordLength := len(node.Sequence)
+	offsets := s.dictionary.offsetsByLength[wordLength]
+
+	blocks := scanBlocks(s.pool, len(offsets), func(start, end int) []int {
+		found := make([]int, 0)
+		for _, offset := range offsets[start:end] {
+			if s.reached[offset] != nil {
+				continue
+			}
+			word := s.dictionary.data[offset : offset+wordLength]
+			if calculateSequenceCost(node.Sequence, word) == 1 && !bytes.Equal(word, s.start) && !bytes.Equal(word, s.endNode.Sequence) {
+				found = append(found, offset)
+			}
+		}
+		return found
+	})
+	for _, block := range blocks {
+		for _, offset := range block {
+			s.reached[offset] = &sequenceNode[byte]{Sequence: s.dictionary.data[offset : offset+wordLength]}
+			childrenNodes = append(childrenNodes, s.reached[offset])
+		}
+	}
+	if !s.foundEnd && calculateSequenceCost(node.Sequence, s.endNode.Sequence) == 1 {
+		s.foundEnd = true
+		childrenNodes = append(childrenNodes, s.endNode)
+	}
+
+	return childrenNodes
+}
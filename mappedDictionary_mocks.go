@@ -0,0 +1,7 @@
+package wordPathAnalyser
+
+//Structs used to hold the mocked input.
+type mappedWordDictionaryMockInput struct {
+	FileLocation, Delimiter string
+	Words                   []string
+}
@@ -0,0 +1,131 @@
+package wordPathAnalyser
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+)
+
+//Test that every word in a file is kept by the mapped dictionary in file order.
+func TestLoadMappedWordDictionary(t *testing.T) {
+	fmt.Println("Testing mapped dictionary loader method: 'LoadMappedWordDictionary'....")
+
+	//Arrange
+	windowsFile := filepath.Join(t.TempDir(), "windows.txt")
+	if err := os.WriteFile(windowsFile, []byte("cold\r\ncord\r\n\r\ncat\r\ncord\r\nwarm"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	emptyFile := filepath.Join(t.TempDir(), "empty.txt")
+	if err := os.WriteFile(emptyFile, []byte{}, 0644); err != nil {
+		t.Fatal(err)
+	}
+	testInputs := []mappedWordDictionaryMockInput{
+		{FileLocation: "./testInput.txt", Delimiter: "", Words: []string{"test", "pest", "post", "most", "fail"}},
+		{FileLocation: "./testInputDelimited.txt", Delimiter: ",", Words: []string{"test", "pest", "post", "most", "fail"}},
+		{FileLocation: windowsFile, Delimiter: "", Words: []string{"cat", "cold", "cord", "cord", "warm"}},
+		{FileLocation: emptyFile, Delimiter: "", Words: []string{}},
+	}
+
+	for i, input := range testInputs {
+		fmt.Print("Test ", i+1, " of ", len(testInputs))
+		//Act
+		dictionary := LoadMappedWordDictionary(input.FileLocation, input.Delimiter)
+		result := mappedWords(dictionary)
+		length := dictionary.Len()
+		err := dictionary.Close()
+
+		//Assert
+		if !doArraysMatch(input.Words, result) || length != len(input.Words) || err != nil {
+			t.Error(
+				"Test number ", i+1, "\n",
+				"Given the inputs:\n",
+				"file location = ", input.FileLocation, "\n",
+				"delimiter = ", input.Delimiter, "\n",
+				"Expected results to be:\n",
+				"Words = ", input.Words, "\n",
+				"Length = ", len(input.Words), "\n",
+				"Actual results were:\n",
+				"Words = ", result, "\n",
+				"Length = ", length, "\n",
+				"Close Error = ", err, "\n",
+			)
+			fmt.Println(" - failed.")
+		} else {
+			fmt.Println(" - passed.")
+		}
+	}
+	fmt.Print("\n")
+}
+
+//Test that a search of the mapped dictionary finds the same path as a search of the file, including when the scans are split across goroutines.
+func TestMappedWordDictionaryAStarAnalyse(t *testing.T) {
+	fmt.Println("Testing mapped dictionary A* method: 'AStarAnalyse'....")
+
+	//Arrange
+	defer runtime.GOMAXPROCS(runtime.GOMAXPROCS(4))
+	largeFile := filepath.Join(t.TempDir(), "large.txt")
+	if err := os.WriteFile(largeFile, []byte(strings.Join(append(benchmarkWords(), "cold", "abcd"), "\n")), 0644); err != nil {
+		t.Fatal(err)
+	}
+	testInputs := []aStarAnalyseMockInput{
+		{StartWord: "test", EndWord: "most", FileLocation: "./testInput.txt", Delimiter: ""},
+		{StartWord: "pest", EndWord: "post", FileLocation: "./testInput.txt", Delimiter: ""},
+		{StartWord: "test", EndWord: "fail", FileLocation: "./testInput.txt", Delimiter: ""},
+		{StartWord: "test", EndWord: "most", FileLocation: "./testInputDelimited.txt", Delimiter: ","},
+		{StartWord: "bolt", EndWord: "warm", FileLocation: "./testInputGraph.txt", Delimiter: ""},
+		{StartWord: "cat", EndWord: "dog", FileLocation: "./testInputGraph.txt", Delimiter: ""},
+		{StartWord: "cold", EndWord: "hold", FileLocation: "./testInputGraph.txt", Delimiter: ""},
+		{StartWord: "cold", EndWord: "cold", FileLocation: "./testInputGraph.txt", Delimiter: ""},
+		{StartWord: "cat", EndWord: "cold", FileLocation: "./testInputGraph.txt", Delimiter: ""},
+		{StartWord: "cold", EndWord: "cat", FileLocation: "./testInputGraph.txt", Delimiter: ""},
+		{StartWord: "abcd", EndWord: "hgfe", FileLocation: largeFile, Delimiter: ""},
+		{StartWord: "cold", EndWord: "dead", FileLocation: largeFile, Delimiter: ""},
+		{StartWord: "hhhh", EndWord: "aaaa", FileLocation: largeFile, Delimiter: ""},
+	}
+
+	for i, input := range testInputs {
+		fmt.Print("Test ", i+1, " of ", len(testInputs))
+		expectedFound, expectedPath := AStarAnalyseFile(input.StartWord, input.EndWord, input.FileLocation, input.Delimiter)
+		dictionary := LoadMappedWordDictionary(input.FileLocation, input.Delimiter)
+
+		//Act
+		pathFound, resultPath := dictionary.AStarAnalyse(input.StartWord, input.EndWord)
+		dictionary.Close()
+
+		//Assert
+		if pathFound != expectedFound || !doArraysMatch(expectedPath, resultPath) {
+			t.Error(
+				"Test number ", i+1, "\n",
+				"Given the inputs:\n",
+				"start word = ", input.StartWord, "\n",
+				"end word = ", input.EndWord, "\n",
+				"file location = ", input.FileLocation, "\n",
+				"Expected results to be:\n",
+				"Path Found = ", expectedFound, "\n",
+				"Result Path = ", expectedPath, "\n",
+				"Actual results were:\n",
+				"Path Found = ", pathFound, "\n",
+				"Result Path = ", resultPath, "\n",
+			)
+			fmt.Println(" - failed.")
+		} else {
+			fmt.Println(" - passed.")
+		}
+	}
+	fmt.Print("\n")
+}
+
+//-----------INTERNAL FUNCTIONS-----------\\
+//List the words of a mapped dictionary grouped by word length (shortest first, each length in file order).
+func mappedWords(d *MappedWordDictionary) []string {
+	words := make([]string, 0)
+	for wordLength := 1; len(words) < d.Len(); wordLength++ {
+		for _, offset := range d.offsetsByLength[wordLength] {
+			words = append(words, string(d.data[offset:offset+wordLength]))
+		}
+	}
+	return words
+}
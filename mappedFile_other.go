@@ -0,0 +1,14 @@
+//go:build !unix
+
+package wordPathAnalyser
+
+import "os"
+
+//Read a file into memory, memory mapping is not supported on this system so the whole file is read instead.
+func mapFile(fL string) (data []byte, unmap func() error, err error) {
+	data, err = os.ReadFile(fL)
+	if err != nil {
+		return nil, nil, err
+	}
+	return data, func() error { return nil }, nil
+}
@@ -0,0 +1,37 @@
+//go:build unix
+
+package wordPathAnalyser
+
+import (
+	"fmt"
+	"os"
+	"syscall"
+)
+
+//Map a file into memory as read only, the returned function unmaps it once the contents are no longer used.
+func mapFile(fL string) (data []byte, unmap func() error, err error) {
+	file, err := os.Open(fL)
+	if err != nil {
+		return nil, nil, err
+	}
+	//The mapping stays valid after the file is closed.
+	defer file.Close()
+
+	info, err := file.Stat()
+	if err != nil {
+		return nil, nil, err
+	}
+	//An empty file cannot be mapped, so there is nothing to unmap.
+	if info.Size() == 0 {
+		return []byte{}, func() error { return nil }, nil
+	}
+	if int64(int(info.Size())) != info.Size() {
+		return nil, nil, fmt.Errorf("%s: file is too large to map", fL)
+	}
+
+	data, err = syscall.Mmap(int(file.Fd()), 0, int(info.Size()), syscall.PROT_READ, syscall.MAP_SHARED)
+	if err != nil {
+		return nil, nil, err
+	}
+	return data, func() error { return syscall.Munmap(data) }, nil
+}
@@ -0,0 +1,131 @@
+package wordPathAnalyser
+
+import "math"
+
+//Estimated cost used for a word that can never reach a target under the move constraints.
+const unreachableCost = math.MaxInt32
+
+//MoveConstraints limits the letter changes a search is allowed to make.
+type MoveConstraints struct {
+	//Positions (from an index of 0) whose letter can never change, for example 0 locks the first letter.
+	LockedPositions []int
+	//Only allow a vowel to be changed into another vowel.
+	VowelsOnly bool
+	//Do not allow the same position to be changed twice in a row.
+	NoRepeatPosition bool
+}
+
+//moveRules holds the moves generateNodeChildren is allowed to make from a word.
+type moveRules struct {
+	//Set of words that cannot be moved to.
+	excluded map[string]bool
+	//Constraints on the letters that can change.
+	constraints MoveConstraints
+	//Set of the locked positions.
+	locked map[int]bool
+	//Most letters that can change in a single move.
+	maxChanged int
+	//True when two letters next to each other can be swapped in a move.
+	swaps bool
+	//Words keyed by their letters in sorted order, any word can be rearranged into another with the same key in a move (**nil if anagrams are not allowed**)
+	anagrams map[string][]string
+}
+
+//Create the move rules for a set of excluded words, move constraints and the most letters that can change in a move (**values below 1 are taken as 1**)
+func newMoveRules(excluded map[string]bool, c MoveConstraints, maxChanged int) *moveRules {
+	m := &moveRules{excluded: excluded, constraints: c, locked: make(map[int]bool, len(c.LockedPositions)), maxChanged: maxChanged}
+	for _, position := range c.LockedPositions {
+		m.locked[position] = true
+	}
+	if m.maxChanged < 1 {
+		m.maxChanged = 1
+	}
+	return m
+}
+
+//Check if a word cannot be moved to (**nil move rules exclude no words**)
+func (m *moveRules) isExcluded(word string) bool {
+	return m != nil && m.excluded[word]
+}
+
+//Find the most letters that can change in a single move (**nil move rules allow 1**)
+func (m *moveRules) maxLetters() int {
+	if m == nil {
+		return 1
+	}
+	return m.maxChanged
+}
+
+//Check if a move can rearrange letters rather than only change them.
+func (m *moveRules) rearranges() bool {
+	return m != nil && (m.swaps || m.anagrams != nil)
+}
+
+//Check if changing the letters that are different from the node's word to the child word is allowed (**nil move rules allow every change**)
+func (m *moveRules) allows(node *aStarWordNode, child string) bool {
+	if m == nil {
+		return true
+	}
+	for i := 0; i < len(child); i++ {
+		if node.Word[i] == child[i] {
+			continue
+		}
+		if m.locked[i] {
+			return false
+		}
+		if m.constraints.VowelsOnly && !(isVowel(node.Word[i]) && isVowel(child[i])) {
+			return false
+		}
+		//A position changed in the move to the node cannot be changed again straight away.
+		if m.constraints.NoRepeatPosition && node.ParentNode != nil && node.ParentNode.Word[i] != node.Word[i] {
+			return false
+		}
+	}
+	return true
+}
+
+//Check if the target can ever be reached from a word of the same length, only letters that are allowed to change can be different.
+func (m *moveRules) canReach(word, target string) bool {
+	if m == nil {
+		return true
+	}
+	for i := 0; i < len(word); i++ {
+		if word[i] == target[i] {
+			continue
+		}
+		if m.locked[i] || (m.constraints.VowelsOnly && !(isVowel(word[i]) && isVowel(target[i]))) {
+			return false
+		}
+	}
+	return true
+}
+
+//Add to the estimate from a word to a target for the extra cost the constraints force, previous is the word before (empty for a start word).
+//When every letter still different was changed in the last move none of them can be changed next,
+//so a letter that already matches has to be changed and then changed back (the detour cost).
+//The letter cannot be changed back in the move straight after, so the detour always takes 2 extra moves.
+func (m *moveRules) constrainedEstimate(word, target, previous string, estimate, detourCost int) int {
+	if !m.canReach(word, target) {
+		return unreachableCost
+	}
+	if m == nil || !m.constraints.NoRepeatPosition || previous == "" || word == target {
+		return estimate
+	}
+	for i := 0; i < len(word); i++ {
+		if word[i] != target[i] && previous[i] == word[i] {
+			return estimate
+		}
+	}
+	return estimate + detourCost
+}
+
+//Create a key of the positions that are different between two words of the same length.
+func changedPositions(from, to string) string {
+	positions := make([]byte, 0, len(from))
+	for i := 0; i < len(from); i++ {
+		if from[i] != to[i] {
+			positions = append(positions, byte(i))
+		}
+	}
+	return string(positions)
+}
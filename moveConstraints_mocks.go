@@ -0,0 +1,20 @@
+package wordPathAnalyser
+
+//Structs used to hold the mocked input.
+type constrainedAStarAnalyseMockInput struct {
+	Dictionary         *WordDictionary
+	StartWord, EndWord string
+	Options            SearchOptions
+	PathFound          bool
+	ResultPath         []string
+}
+type constrainedEstimateMockInput struct {
+	Constraints            MoveConstraints
+	Word, Target, Previous string
+	Estimate, DetourCost   int
+	Result                 int
+}
+type searchRulesDetourMockInput struct {
+	Options SearchOptions
+	Result  int
+}
@@ -0,0 +1,150 @@
+package wordPathAnalyser
+
+import (
+	"fmt"
+	"testing"
+)
+
+//Test that the move constraints limit the path found by the A* search.
+func TestWordDictionaryAStarAnalyseWithConstraints(t *testing.T) {
+	fmt.Println("Testing dictionary constrained A* method: 'AStarAnalyseWithOptions'....")
+
+	//Arrange
+	dictionary := LoadWordFrequencyDictionary("./testInputFrequency.txt")
+	//Changing a to o costs more than changing a to u then u to o.
+	substitutions := NewSubstitutionCostMatrix(5)
+	substitutions.SetCost('a', 'u', 1)
+	substitutions.SetCost('u', 'o', 1)
+	vowelDictionary := NewWordDictionary([]string{"cat", "cot", "cut"})
+	testInputs := []constrainedAStarAnalyseMockInput{
+		{Dictionary: dictionary, StartWord: "test", EndWord: "most", Options: SearchOptions{},
+			PathFound: true, ResultPath: []string{"most", "tost", "test"}},
+		{Dictionary: dictionary, StartWord: "test", EndWord: "most", Options: SearchOptions{Constraints: MoveConstraints{LockedPositions: []int{1}}},
+			PathFound: false, ResultPath: []string{}},
+		{Dictionary: dictionary, StartWord: "best", EndWord: "mist", Options: SearchOptions{Constraints: MoveConstraints{LockedPositions: []int{2, 3}}},
+			PathFound: true, ResultPath: []string{"mist", "must", "bust", "best"}},
+		{Dictionary: dictionary, StartWord: "test", EndWord: "most", Options: SearchOptions{Constraints: MoveConstraints{VowelsOnly: true}},
+			PathFound: false, ResultPath: []string{}},
+		{Dictionary: dictionary, StartWord: "must", EndWord: "mist", Options: SearchOptions{Constraints: MoveConstraints{VowelsOnly: true}},
+			PathFound: true, ResultPath: []string{"mist", "must"}},
+		{Dictionary: vowelDictionary, StartWord: "cat", EndWord: "cot", Options: SearchOptions{Substitutions: substitutions},
+			PathFound: true, ResultPath: []string{"cot", "cut", "cat"}},
+		{Dictionary: vowelDictionary, StartWord: "cat", EndWord: "cot", Options: SearchOptions{Substitutions: substitutions, Constraints: MoveConstraints{NoRepeatPosition: true}},
+			PathFound: true, ResultPath: []string{"cot", "cat"}},
+	}
+
+	for i, input := range testInputs {
+		fmt.Print("Test ", i+1, " of ", len(testInputs))
+		//Act
+		pathFound, resultPath := input.Dictionary.AStarAnalyseWithOptions(input.StartWord, input.EndWord, input.Options)
+
+		//Assert
+		if pathFound != input.PathFound || !doArraysMatch(input.ResultPath, resultPath) {
+			t.Error(
+				"Test number ", i+1, "\n",
+				"Given the inputs:\n",
+				"start word = ", input.StartWord, "\n",
+				"end word = ", input.EndWord, "\n",
+				"constraints = ", input.Options.Constraints, "\n",
+				"Expected results to be:\n",
+				"Path Found = ", input.PathFound, "\n",
+				"Result Path = ", input.ResultPath, "\n",
+				"Actual results were:\n",
+				"Path Found = ", pathFound, "\n",
+				"Result Path = ", resultPath, "\n",
+			)
+			fmt.Println(" - failed.")
+		} else {
+			fmt.Println(" - passed.")
+		}
+	}
+	fmt.Print("\n")
+}
+
+//Test the estimate is raised for the steps the constraints force.
+func TestConstrainedEstimate(t *testing.T) {
+	fmt.Println("Testing constrained estimate method: 'constrainedEstimate'....")
+
+	//Arrange
+	testInputs := []constrainedEstimateMockInput{
+		{Constraints: MoveConstraints{}, Word: "test", Target: "most", Previous: "", Estimate: 2, DetourCost: 2, Result: 2},
+		{Constraints: MoveConstraints{LockedPositions: []int{0}}, Word: "test", Target: "most", Previous: "", Estimate: 2, DetourCost: 2, Result: unreachableCost},
+		{Constraints: MoveConstraints{LockedPositions: []int{0}}, Word: "test", Target: "tost", Previous: "", Estimate: 1, DetourCost: 2, Result: 1},
+		{Constraints: MoveConstraints{VowelsOnly: true}, Word: "test", Target: "tost", Previous: "", Estimate: 1, DetourCost: 2, Result: 1},
+		{Constraints: MoveConstraints{VowelsOnly: true}, Word: "test", Target: "best", Previous: "", Estimate: 1, DetourCost: 2, Result: unreachableCost},
+		{Constraints: MoveConstraints{NoRepeatPosition: true}, Word: "cut", Target: "cot", Previous: "cat", Estimate: 1, DetourCost: 2, Result: 3},
+		{Constraints: MoveConstraints{NoRepeatPosition: true}, Word: "cut", Target: "cot", Previous: "cat", Estimate: 4, DetourCost: 4, Result: 8},
+		{Constraints: MoveConstraints{NoRepeatPosition: true}, Word: "cut", Target: "cot", Previous: "hut", Estimate: 1, DetourCost: 2, Result: 1},
+		{Constraints: MoveConstraints{NoRepeatPosition: true}, Word: "cut", Target: "cot", Previous: "", Estimate: 1, DetourCost: 2, Result: 1},
+		{Constraints: MoveConstraints{NoRepeatPosition: true}, Word: "cut", Target: "cog", Previous: "cat", Estimate: 2, DetourCost: 2, Result: 2},
+		{Constraints: MoveConstraints{NoRepeatPosition: true}, Word: "dog", Target: "cog", Previous: "cot", Estimate: 1, DetourCost: 1, Result: 2},
+	}
+
+	for i, input := range testInputs {
+		fmt.Print("Test ", i+1, " of ", len(testInputs))
+		//Act
+		result := newMoveRules(nil, input.Constraints, 1).constrainedEstimate(input.Word, input.Target, input.Previous, input.Estimate, input.DetourCost)
+
+		//Assert
+		if result != input.Result {
+			t.Error(
+				"Test number ", i+1, "\n",
+				"Given the inputs:\n",
+				"constraints = ", input.Constraints, "\n",
+				"word = ", input.Word, "\n",
+				"target = ", input.Target, "\n",
+				"previous word = ", input.Previous, "\n",
+				"estimate = ", input.Estimate, "\n",
+				"Expected result to be:\n",
+				input.Result, "\n",
+				"Actual result was:\n",
+				result, "\n",
+			)
+			fmt.Println(" - failed.")
+		} else {
+			fmt.Println(" - passed.")
+		}
+	}
+	fmt.Print("\n")
+}
+
+//Test that the detour forced by not changing a position twice in a row costs 2 extra steps however many letters a step can change.
+func TestSearchRulesDetourCost(t *testing.T) {
+	fmt.Println("Testing search rules detour cost method: 'searchRules'....")
+
+	//Arrange
+	dictionary := LoadWordDictionary("./testInputGraph.txt", "")
+	noRepeat := MoveConstraints{NoRepeatPosition: true}
+	testInputs := []searchRulesDetourMockInput{
+		{Options: SearchOptions{Constraints: noRepeat}, Result: 2},
+		{Options: SearchOptions{Constraints: noRepeat, MaxLettersChanged: 2}, Result: 2},
+		{Options: SearchOptions{Constraints: noRepeat, MaxLettersChanged: 3}, Result: 2},
+		{Options: SearchOptions{Constraints: noRepeat, MaxLettersChanged: 2, CostPerLetterChanged: true}, Result: 2},
+		{Options: SearchOptions{Constraints: noRepeat, MaxLettersChanged: 2, SwapMoves: true}, Result: 0},
+	}
+
+	for i, input := range testInputs {
+		fmt.Print("Test ", i+1, " of ", len(testInputs))
+		state := dictionary.goalSearchState([]string{"cold"}, GoalWord("warm"))
+
+		//Act
+		result := dictionary.searchRules(GoalWord("warm"), input.Options, state.scanned).detourCost
+
+		//Assert
+		if result != input.Result {
+			t.Error(
+				"Test number ", i+1, "\n",
+				"Given the inputs:\n",
+				"options = ", input.Options, "\n",
+				"Expected result to be:\n",
+				input.Result, "\n",
+				"Actual result was:\n",
+				result, "\n",
+			)
+			fmt.Println(" - failed.")
+		} else {
+			fmt.Println(" - passed.")
+		}
+	}
+	fmt.Print("\n")
+}
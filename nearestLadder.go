@@ -0,0 +1,31 @@
+package wordPathAnalyser
+
+//NearestLadder holds the lowest cost ladder found between any of a set of start words and any goal word.
+type NearestLadder struct {
+	//True when a ladder was found.
+	PathFound bool
+	//The start word and goal word the ladder joins.
+	StartWord, EndWord string
+	//Path from the end word to the start word (if no path is found emtpy array is returned).
+	ResultPath []string
+}
+
+//AStarAnalyseNearest uses the A* Graphing Algorythm to find the lowest cost ladder from any of the start words to any word that meets the goal.
+//Every start word is added to the open list at the start of the search, so the search is run once rather than once per pair of words.
+//INPUTS: start words ([]string), goal (SearchGoal) (**Use GoalWords for a set of end words**), search options (SearchOptions)
+//OUTPUT: the ladder found and the pair of words it joins (NearestLadder)
+func (d *WordDictionary) AStarAnalyseNearest(startWords []string, g SearchGoal, o SearchOptions) NearestLadder {
+	result := NearestLadder{ResultPath: []string{}}
+	if len(startWords) == 0 {
+		return result
+	}
+
+	state := d.goalSearchState(startWords, g)
+	result.PathFound, result.ResultPath = weightedAStarAnalyse(startWords, state, d.searchRules(g, o, state.scanned))
+	if result.PathFound {
+		result.EndWord = result.ResultPath[0]
+		result.StartWord = result.ResultPath[len(result.ResultPath)-1]
+	}
+
+	return result
+}
@@ -0,0 +1,7 @@
+package wordPathAnalyser
+
+//Structs used to hold the mocked input.
+type nearestLadderMockInput struct {
+	StartWords, EndWords []string
+	Result               NearestLadder
+}
@@ -0,0 +1,52 @@
+package wordPathAnalyser
+
+import (
+	"fmt"
+	"testing"
+)
+
+//Test that the shortest ladder between any start word and any end word is found with the pair it joins.
+func TestWordDictionaryAStarAnalyseNearest(t *testing.T) {
+	fmt.Println("Testing dictionary nearest ladder method: 'AStarAnalyseNearest'....")
+
+	//Arrange
+	dictionary := LoadWordDictionary("./testInputGraph.txt", "")
+	testInputs := []nearestLadderMockInput{
+		{StartWords: []string{"bolt", "care"}, EndWords: []string{"warm", "cold"},
+			Result: NearestLadder{PathFound: true, StartWord: "bolt", EndWord: "cold", ResultPath: []string{"cold", "bold", "bolt"}}},
+		{StartWords: []string{"gold", "ward"}, EndWords: []string{"worm", "core"},
+			Result: NearestLadder{PathFound: true, StartWord: "ward", EndWord: "worm", ResultPath: []string{"worm", "warm", "ward"}}},
+		{StartWords: []string{"cat", "cold"}, EndWords: []string{"dog", "warm"},
+			Result: NearestLadder{PathFound: true, StartWord: "cat", EndWord: "dog", ResultPath: []string{"dog", "dot", "cot", "cat"}}},
+		{StartWords: []string{"cat", "gold"}, EndWords: []string{"hold"},
+			Result: NearestLadder{PathFound: true, StartWord: "gold", EndWord: "hold", ResultPath: []string{"hold", "gold"}}},
+		{StartWords: []string{"cat", "cot"}, EndWords: []string{"warm"},
+			Result: NearestLadder{PathFound: false, ResultPath: []string{}}},
+		{StartWords: []string{}, EndWords: []string{"warm"},
+			Result: NearestLadder{PathFound: false, ResultPath: []string{}}},
+	}
+
+	for i, input := range testInputs {
+		fmt.Print("Test ", i+1, " of ", len(testInputs))
+		//Act
+		result := dictionary.AStarAnalyseNearest(input.StartWords, GoalWords(input.EndWords), SearchOptions{})
+
+		//Assert
+		if fmt.Sprint(input.Result) != fmt.Sprint(result) {
+			t.Error(
+				"Test number ", i+1, "\n",
+				"Given the inputs:\n",
+				"start words = ", input.StartWords, "\n",
+				"end words = ", input.EndWords, "\n",
+				"Expected result to be:\n",
+				"Ladder = ", input.Result, "\n",
+				"Actual result was:\n",
+				"Ladder = ", result, "\n",
+			)
+			fmt.Println(" - failed.")
+		} else {
+			fmt.Println(" - passed.")
+		}
+	}
+	fmt.Print("\n")
+}
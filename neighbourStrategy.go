@@ -0,0 +1,96 @@
+package wordPathAnalyser
+
+import "sort"
+
+//NeighbourStrategy is how the words one letter change from a word are found.
+type NeighbourStrategy int
+
+const (
+	//NeighbourAuto chooses between scanning and generating from the number of words, the alphabet size and the word length.
+	NeighbourAuto NeighbourStrategy = iota
+	//NeighbourScan compares the word with every dictionary word of the same length.
+	NeighbourScan
+	//NeighbourGenerate tries every letter of the alphabet at every position and looks each candidate up in the dictionary.
+	NeighbourGenerate
+)
+
+//Cost of generating and looking up a candidate word compared to comparing the word with a dictionary word.
+const candidateLookupCost = 4
+
+//Choose the strategy to use, scanning compares every word while generating looks up (alphabet size - 1) candidates for each letter of the word.
+func chooseNeighbourStrategy(s NeighbourStrategy, wordCount, alphabetSize, wordLength int) NeighbourStrategy {
+	if s != NeighbourAuto {
+		return s
+	}
+	if wordLength*(alphabetSize-1)*candidateLookupCost < wordCount {
+		return NeighbourGenerate
+	}
+	return NeighbourScan
+}
+
+//Find every letter used in the words (in byte order).
+func alphabetOf(words []string) []byte {
+	//Set of the letters used.
+	var used [256]bool
+	for _, word := range words {
+		for i := 0; i < len(word); i++ {
+			used[word[i]] = true
+		}
+	}
+
+	alphabet := make([]byte, 0)
+	for letter, isUsed := range used {
+		if isUsed {
+			alphabet = append(alphabet, byte(letter))
+		}
+	}
+	return alphabet
+}
+
+//Generate every word made by changing one letter of the word into another letter of the alphabet and keep the candidates that are words.
+//The words are returned in the order given by order (for example file order) so the result matches scanning the dictionary.
+func generateCandidateNeighbours(word string, alphabet []byte, isWord func(word string) bool, order func(word string) int) []string {
+	neighbours := make([]string, 0)
+	candidate := []byte(word)
+	for i := range candidate {
+		for _, letter := range alphabet {
+			if letter == word[i] {
+				continue
+			}
+			candidate[i] = letter
+			if isWord(string(candidate)) {
+				neighbours = append(neighbours, string(candidate))
+			}
+		}
+		candidate[i] = word[i]
+	}
+
+	sort.Slice(neighbours, func(i, j int) bool { return order(neighbours[i]) < order(neighbours[j]) })
+	return neighbours
+}
+
+//Create the neighbour generator for a search through the word nodes, nil when scanning the nodes is the better strategy.
+//Neighbours are returned in the same order as the nodes so either strategy finds the same path.
+func candidateNeighbours(s NeighbourStrategy, wordDictionary []*aStarWordNode) func(word string) []string {
+	//Position of each word in the nodes and the longest word.
+	order := make(map[string]int, len(wordDictionary))
+	wordLength := 0
+	for i, node := range wordDictionary {
+		order[node.Word] = i
+		if len(node.Word) > wordLength {
+			wordLength = len(node.Word)
+		}
+	}
+	alphabet := alphabetOf(nodeWords(wordDictionary))
+	if chooseNeighbourStrategy(s, len(wordDictionary), len(alphabet), wordLength) != NeighbourGenerate {
+		return nil
+	}
+
+	isWord := func(word string) bool {
+		_, found := order[word]
+		return found
+	}
+	return func(word string) []string {
+		return generateCandidateNeighbours(word, alphabet, isWord, func(word string) int { return order[word] })
+	}
+}
@@ -0,0 +1,12 @@
+package wordPathAnalyser
+
+//Structs used to hold the mocked input.
+type chooseNeighbourStrategyMockInput struct {
+	Strategy                            NeighbourStrategy
+	WordCount, AlphabetSize, WordLength int
+	Result                              NeighbourStrategy
+}
+type candidateNeighboursMockInput struct {
+	Word   string
+	Result []string
+}
@@ -0,0 +1,189 @@
+package wordPathAnalyser
+
+import (
+	"fmt"
+	"testing"
+)
+
+//Test that generating is only chosen when there are many more words than candidates.
+func TestChooseNeighbourStrategy(t *testing.T) {
+	fmt.Println("Testing neighbour strategy choice method: 'chooseNeighbourStrategy'....")
+
+	//Arrange
+	testInputs := []chooseNeighbourStrategyMockInput{
+		{Strategy: NeighbourAuto, WordCount: 5000, AlphabetSize: 26, WordLength: 4, Result: NeighbourGenerate},
+		{Strategy: NeighbourAuto, WordCount: 300, AlphabetSize: 26, WordLength: 4, Result: NeighbourScan},
+		{Strategy: NeighbourAuto, WordCount: 5000, AlphabetSize: 26, WordLength: 60, Result: NeighbourScan},
+		{Strategy: NeighbourAuto, WordCount: 300, AlphabetSize: 4, WordLength: 20, Result: NeighbourGenerate},
+		{Strategy: NeighbourScan, WordCount: 5000, AlphabetSize: 26, WordLength: 4, Result: NeighbourScan},
+		{Strategy: NeighbourGenerate, WordCount: 10, AlphabetSize: 26, WordLength: 4, Result: NeighbourGenerate},
+	}
+
+	for i, input := range testInputs {
+		fmt.Print("Test ", i+1, " of ", len(testInputs))
+		//Act
+		result := chooseNeighbourStrategy(input.Strategy, input.WordCount, input.AlphabetSize, input.WordLength)
+
+		//Assert
+		if result != input.Result {
+			t.Error(
+				"Test number ", i+1, "\n",
+				"Given the inputs:\n",
+				"strategy = ", input.Strategy, "\n",
+				"word count = ", input.WordCount, "\n",
+				"alphabet size = ", input.AlphabetSize, "\n",
+				"word length = ", input.WordLength, "\n",
+				"Expected result to be:\n",
+				input.Result, "\n",
+				"Actual result was:\n",
+				result, "\n",
+			)
+			fmt.Println(" - failed.")
+		} else {
+			fmt.Println(" - passed.")
+		}
+	}
+	fmt.Print("\n")
+}
+
+//Test that generated neighbours match the neighbours found by scanning, in file order.
+func TestGenerateCandidateNeighbours(t *testing.T) {
+	fmt.Println("Testing candidate neighbour generation method: 'generateCandidateNeighbours'....")
+
+	//Arrange
+	dictionary := LoadWordDictionary("./testInputGraph.txt", "")
+	order := func(word string) int { return dictionary.wordIndex[word] }
+	testInputs := []candidateNeighboursMockInput{
+		{Word: "cord", Result: []string{"cold", "card", "word", "core"}},
+		{Word: "cot", Result: []string{"cat", "dot", "cog"}},
+		{Word: "bolt", Result: []string{"bold"}},
+		{Word: "hold", Result: []string{"cold", "gold", "bold"}},
+		{Word: "zzzz", Result: []string{}},
+	}
+
+	for i, input := range testInputs {
+		fmt.Print("Test ", i+1, " of ", len(testInputs))
+		//Act
+		result := generateCandidateNeighbours(input.Word, dictionary.alphabet, dictionary.Contains, order)
+
+		//Assert
+		if !doArraysMatch(input.Result, result) || !doArraysMatch(dictionary.Neighbours(input.Word), result) {
+			t.Error(
+				"Test number ", i+1, "\n",
+				"Given the inputs:\n",
+				"word = ", input.Word, "\n",
+				"Expected result to be:\n",
+				input.Result, "\n",
+				"Actual result was:\n",
+				result, "\n",
+			)
+			fmt.Println(" - failed.")
+		} else {
+			fmt.Println(" - passed.")
+		}
+	}
+	fmt.Print("\n")
+}
+
+//Test that the A* search finds the same path whether neighbours are scanned or generated.
+func TestWordDictionaryAStarAnalyseNeighbourStrategies(t *testing.T) {
+	fmt.Println("Testing dictionary A* neighbour strategies method: 'AStarAnalyseWithOptions'....")
+
+	//Arrange
+	dictionary := LoadWordFrequencyDictionary("./testInputFrequency.txt")
+	testInputs := []weightedAStarAnalyseMockInput{
+		{StartWord: "test", EndWord: "most", Options: SearchOptions{},
+			PathFound: true, ResultPath: []string{"most", "tost", "test"}},
+		{StartWord: "best", EndWord: "mist", Options: SearchOptions{PreferCommonWords: true},
+			PathFound: true, ResultPath: []string{"mist", "most", "post", "pest", "best"}},
+		{StartWord: "test", EndWord: "most", Options: SearchOptions{Exclude: []string{"tost"}},
+			PathFound: true, ResultPath: []string{"most", "post", "pest", "test"}},
+		{StartWord: "test", EndWord: "mosh", Options: SearchOptions{},
+			PathFound: true, ResultPath: []string{"mosh", "most", "tost", "test"}},
+		{StartWord: "test", EndWord: "fail", Options: SearchOptions{},
+			PathFound: false, ResultPath: []string{}},
+	}
+
+	for i, input := range testInputs {
+		fmt.Print("Test ", i+1, " of ", len(testInputs))
+		//Act
+		scanOptions, generateOptions := input.Options, input.Options
+		scanOptions.Neighbours = NeighbourScan
+		generateOptions.Neighbours = NeighbourGenerate
+		scanFound, scanPath := dictionary.AStarAnalyseWithOptions(input.StartWord, input.EndWord, scanOptions)
+		generateFound, generatePath := dictionary.AStarAnalyseWithOptions(input.StartWord, input.EndWord, generateOptions)
+
+		//Assert
+		if scanFound != input.PathFound || generateFound != input.PathFound || !doArraysMatch(input.ResultPath, scanPath) || !doArraysMatch(input.ResultPath, generatePath) {
+			t.Error(
+				"Test number ", i+1, "\n",
+				"Given the inputs:\n",
+				"start word = ", input.StartWord, "\n",
+				"end word = ", input.EndWord, "\n",
+				"options = ", input.Options, "\n",
+				"Expected results to be:\n",
+				"Path Found = ", input.PathFound, "\n",
+				"Result Path = ", input.ResultPath, "\n",
+				"Actual results were:\n",
+				"Scan Path Found = ", scanFound, "\n",
+				"Scan Result Path = ", scanPath, "\n",
+				"Generate Path Found = ", generateFound, "\n",
+				"Generate Result Path = ", generatePath, "\n",
+			)
+			fmt.Println(" - failed.")
+		} else {
+			fmt.Println(" - passed.")
+		}
+	}
+	fmt.Print("\n")
+}
+
+//Test that graph edges built from generated neighbours match the edges found by scanning every word.
+func TestWordGraphGeneratedEdges(t *testing.T) {
+	fmt.Println("Testing word graph generated edges method: 'edgeBuilder'....")
+
+	//Arrange
+	//Every 3 letter word of a, b and c apart from bbb, there are enough words for the neighbours to be generated.
+	words := make([]string, 0)
+	for _, a := range "abc" {
+		for _, b := range "abc" {
+			for _, c := range "abc" {
+				if word := string([]rune{a, b, c}); word != "bbb" {
+					words = append(words, word)
+				}
+			}
+		}
+	}
+	nodes := make([]*aStarWordNode, len(words))
+	for i, word := range words {
+		node := newAStarWordNode(word)
+		nodes[i] = &node
+	}
+	expected := make([][]int, len(words))
+	for id := range words {
+		children, _ := generateNodeChildren(nodes[id], nodes, nil)
+		expected[id] = make([]int, len(children))
+		for i, child := range children {
+			expected[id][i] = indexOf(words, child.Word)
+		}
+	}
+
+	//Act
+	graph := newWordGraphs(words)[0]
+
+	//Assert
+	if candidateNeighbours(NeighbourAuto, nodes) == nil || fmt.Sprint(expected) != fmt.Sprint(graph.Edges) {
+		t.Error(
+			"Given the inputs:\n",
+			"Words = ", words, "\n",
+			"Expected result to be:\n",
+			expected, "\n",
+			"Actual result was:\n",
+			graph.Edges, "\n",
+		)
+		fmt.Println(" - failed.")
+	} else {
+		fmt.Println(" - passed.")
+	}
+	fmt.Print("\n")
+}
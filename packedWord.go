@@ -0,0 +1,31 @@
+package wordPathAnalyser
+
+import "math/bits"
+
+//Most letters a word can have to be packed into a uint64 (one byte for each letter).
+const maxPackedLetters = 8
+
+//The low 7 bits and the top bit of every byte in a packed word.
+const (
+	packedLowBits uint64 = 0x7f7f7f7f7f7f7f7f
+	packedTopBits uint64 = 0x8080808080808080
+)
+
+//Pack a word into a uint64 with its first letter in the lowest byte so two words can be compared all at once.
+//Returns false if the word is longer than 8 letters or has a letter that is not ASCII, these words are compared a letter at a time.
+func packWord(word string) (packed uint64, isPacked bool) {
+	if len(word) > maxPackedLetters {
+		return 0, false
+	}
+	for i := 0; i < len(word); i++ {
+		packed |= uint64(word[i]) << (8 * i)
+	}
+	return packed, packed&packedTopBits == 0
+}
+
+//Count the letters that are different between two packed words of the same length.
+//XOR leaves a byte that is not zero for each different letter, as every byte is ASCII (below 0x80) adding 0x7f to it
+//sets its top bit only when it is not zero, so the top bits set are the letters that are different.
+func packedDifferences(a, b uint64) int {
+	return bits.OnesCount64(((a ^ b) + packedLowBits) & packedTopBits)
+}
@@ -0,0 +1,11 @@
+package wordPathAnalyser
+
+//Structs used to hold the mocked input.
+type packWordMockInput struct {
+	Word     string
+	IsPacked bool
+}
+type packedDifferencesMockInput struct {
+	StartWord, EndWord string
+	Result             int
+}
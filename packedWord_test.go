@@ -0,0 +1,260 @@
+package wordPathAnalyser
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+//Test that only words of up to 8 ASCII letters are packed.
+func TestPackWord(t *testing.T) {
+	fmt.Println("Testing word packing method: 'packWord'....")
+
+	//Arrange
+	testInputs := []packWordMockInput{
+		{Word: "test", IsPacked: true},
+		{Word: "", IsPacked: true},
+		{Word: "abcdefgh", IsPacked: true},
+		{Word: "abcdefghi", IsPacked: false},
+		{Word: "café", IsPacked: false},
+		{Word: "\x7f\x00", IsPacked: true},
+	}
+
+	for i, input := range testInputs {
+		fmt.Print("Test ", i+1, " of ", len(testInputs))
+		//Act
+		packed, isPacked := packWord(input.Word)
+
+		//Assert
+		//Every letter of a packed word must be in its own byte.
+		unpacked := make([]byte, 0, len(input.Word))
+		for j := 0; isPacked && j < len(input.Word); j++ {
+			unpacked = append(unpacked, byte(packed>>(8*j)))
+		}
+		if isPacked != input.IsPacked || (isPacked && string(unpacked) != input.Word) {
+			t.Error(
+				"Test number ", i+1, "\n",
+				"Given the inputs:\n",
+				"word = ", input.Word, "\n",
+				"Expected results to be:\n",
+				"Is Packed = ", input.IsPacked, "\n",
+				"Actual results were:\n",
+				"Is Packed = ", isPacked, "\n",
+				"Packed Letters = ", unpacked, "\n",
+			)
+			fmt.Println(" - failed.")
+		} else {
+			fmt.Println(" - passed.")
+		}
+	}
+	fmt.Print("\n")
+}
+
+//Test that comparing packed words gives the same number of different letters as comparing a letter at a time.
+func TestPackedDifferences(t *testing.T) {
+	fmt.Println("Testing packed word comparison method: 'packedDifferences'....")
+
+	//Arrange
+	testInputs := []packedDifferencesMockInput{
+		{StartWord: "test", EndWord: "test", Result: 0},
+		{StartWord: "test", EndWord: "best", Result: 1},
+		{StartWord: "test", EndWord: "brag", Result: 4},
+		{StartWord: "planets", EndWord: "planted", Result: 3},
+		{StartWord: "abcdefgh", EndWord: "hgfedcba", Result: 8},
+		{StartWord: "abcdefgh", EndWord: "abcdefgz", Result: 1},
+		{StartWord: "\x7f\x00\x01", EndWord: "\x00\x7f\x01", Result: 2},
+		{StartWord: "", EndWord: "", Result: 0},
+	}
+
+	for i, input := range testInputs {
+		fmt.Print("Test ", i+1, " of ", len(testInputs))
+		a, _ := packWord(input.StartWord)
+		b, _ := packWord(input.EndWord)
+
+		//Act
+		result := packedDifferences(a, b)
+
+		//Assert
+		if result != input.Result || result != calculateNodeCost(input.StartWord, input.EndWord) {
+			t.Error(
+				"Test number ", i+1, "\n",
+				"Given the inputs:\n",
+				"start word = ", input.StartWord, "\n",
+				"end word = ", input.EndWord, "\n",
+				"Expected result to be:\n",
+				input.Result, "\n",
+				"Actual result was:\n",
+				result, "\n",
+			)
+			fmt.Println(" - failed.")
+		} else {
+			fmt.Println(" - passed.")
+		}
+	}
+	fmt.Print("\n")
+}
+
+//Test that words too long or not ASCII enough to be packed are still compared a letter at a time when generating children.
+func TestGenerateNodeChildrenUnpackedWords(t *testing.T) {
+	fmt.Println("Testing generating children nodes method with unpacked words: 'generateNodeChildren'....")
+
+	//Arrange
+	testInputs := []aStarCalculateNodeCostMockInput{
+		{StartWord: "planetary", EndWord: "planetery", Result: 1},
+		{StartWord: "planetary", EndWord: "planetari", Result: 1},
+		{StartWord: "planetary", EndWord: "planetxxx", Result: 0},
+		{StartWord: "café", EndWord: "cafe", Result: 0},
+		{StartWord: "café", EndWord: "bafé", Result: 1},
+		{StartWord: "cafe", EndWord: "safe", Result: 1},
+	}
+
+	for i, input := range testInputs {
+		fmt.Print("Test ", i+1, " of ", len(testInputs))
+		node := newAStarWordNode(input.StartWord)
+		dictNode := newAStarWordNode(input.EndWord)
+
+		//Act
+		children, _ := generateNodeChildren(&node, []*aStarWordNode{&dictNode}, nil)
+
+		//Assert
+		if len(children) != input.Result {
+			t.Error(
+				"Test number ", i+1, "\n",
+				"Given the inputs:\n",
+				"node word = ", input.StartWord, "\n",
+				"dictionary word = ", input.EndWord, "\n",
+				"Expected result to be:\n",
+				"Children = ", input.Result, "\n",
+				"Actual result was:\n",
+				"Children = ", len(children), "\n",
+			)
+			fmt.Println(" - failed.")
+		} else {
+			fmt.Println(" - passed.")
+		}
+	}
+	fmt.Print("\n")
+}
+
+//Total of the benchmark results, kept so the compiler cannot remove the work being timed.
+var benchmarkResult int
+
+//Benchmark comparing two words a letter at a time against packing both words for each comparison and comparing words packed beforehand.
+func BenchmarkCalculateNodeCost(b *testing.B) {
+	words := benchmarkWords()
+	b.Run("letters", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			for _, word := range words {
+				benchmarkResult += calculateNodeCost(word, "dead")
+			}
+		}
+	})
+	b.Run("packed", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			for _, word := range words {
+				target, _ := packWord("dead")
+				packed, _ := packWord(word)
+				benchmarkResult += packedDifferences(packed, target)
+			}
+		}
+	})
+	b.Run("prepacked", func(b *testing.B) {
+		target, _ := packWord("dead")
+		packed := make([]uint64, len(words))
+		for i, word := range words {
+			packed[i], _ = packWord(word)
+		}
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			for _, word := range packed {
+				benchmarkResult += packedDifferences(word, target)
+			}
+		}
+	})
+}
+
+//Benchmark generating children from nodes with packed words against the same nodes compared a letter at a time.
+func BenchmarkGenerateNodeChildren(b *testing.B) {
+	node := newAStarWordNode("dead")
+	for _, isPacked := range []bool{false, true} {
+		dict := make([]*aStarWordNode, 0)
+		for _, word := range benchmarkWords() {
+			dictNode := newAStarWordNode(word)
+			dictNode.isPacked = isPacked
+			dict = append(dict, &dictNode)
+		}
+		name := "letters"
+		if isPacked {
+			name = "packed"
+		}
+		b.Run(name, func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				children, _ := generateNodeChildren(&node, dict, nil)
+				benchmarkResult += len(children)
+			}
+		})
+	}
+}
+
+//Benchmark a search through nodes with packed words against the same search with every word compared a letter at a time.
+func BenchmarkAStarAnalyse(b *testing.B) {
+	for _, isPacked := range []bool{false, true} {
+		name := "letters"
+		if isPacked {
+			name = "packed"
+		}
+		b.Run(name, func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				//The search updates its nodes so each search needs its own.
+				b.StopTimer()
+				dict := make([]*aStarWordNode, 0)
+				for _, word := range benchmarkWords() {
+					if word != "abcd" && word != "hgfe" {
+						dictNode := newAStarWordNode(word)
+						dictNode.isPacked = isPacked
+						dict = append(dict, &dictNode)
+					}
+				}
+				b.StartTimer()
+				pathFound, _ := aStarAnalyse("abcd", "hgfe", dict)
+				if pathFound {
+					benchmarkResult++
+				}
+			}
+		})
+	}
+}
+
+//Benchmark reading a word file and searching it.
+func BenchmarkAStarAnalyseFile(b *testing.B) {
+	file := filepath.Join(b.TempDir(), "words.txt")
+	if err := os.WriteFile(file, []byte(strings.Join(benchmarkWords(), "\n")), 0644); err != nil {
+		b.Fatal(err)
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		pathFound, _ := AStarAnalyseFile("abcd", "hgfe", file, "")
+		if pathFound {
+			benchmarkResult++
+		}
+	}
+}
+
+//-----------INTERNAL FUNCTIONS-----------\\
+//Create every 4 letter word made from the letters a to h.
+func benchmarkWords() []string {
+	words := make([]string, 0, 8*8*8*8)
+	letters := "abcdefgh"
+	for _, a := range letters {
+		for _, b := range letters {
+			for _, c := range letters {
+				for _, d := range letters {
+					words = append(words, string([]rune{a, b, c, d}))
+				}
+			}
+		}
+	}
+	return words
+}
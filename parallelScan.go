@@ -0,0 +1,103 @@
+package wordPathAnalyser
+
+import (
+	"runtime"
+	"sync"
+)
+
+//Fewest words each goroutine scans when a scan is split, a smaller block is quicker to scan than to hand to another goroutine.
+const minScanBlockWords = 2048
+
+//scanPool holds the goroutines one search uses to split its scans, they are started once and used by every scan until the search closes the pool.
+//A nil pool scans on the calling goroutine.
+type scanPool struct {
+	//Most blocks a scan is split into, the calling goroutine scans the first block and the pool's goroutines scan the rest.
+	blocks int
+	//Scans of a block waiting for a goroutine.
+	scans chan func()
+}
+
+//Start a pool for the scans of a search through the given number of words (at most GOMAXPROCS goroutines).
+//nil is returned when the words are too few to split, see minScanBlockWords.
+func newScanPool(words int) *scanPool {
+	blocks := runtime.GOMAXPROCS(0)
+	if maxBlocks := words / minScanBlockWords; blocks > maxBlocks {
+		blocks = maxBlocks
+	}
+	if blocks < 2 {
+		return nil
+	}
+
+	p := &scanPool{blocks: blocks, scans: make(chan func())}
+	for i := 1; i < blocks; i++ {
+		go func() {
+			for scan := range p.scans {
+				scan()
+			}
+		}()
+	}
+	return p
+}
+
+//Stop the pool's goroutines once the search has finished with it (**a nil pool can be closed**)
+func (p *scanPool) close() {
+	if p != nil {
+		close(p.scans)
+	}
+}
+
+//Split a scan of the IDs from 0 to count-1 into blocks of IDs next to each other and scan each block on a goroutine of the pool.
+//The results are returned in block order, so joining them gives the same result as scanning every ID in order on one goroutine.
+//Blocks of IDs are used rather than wildcard buckets as they are always the same size, even as a search removes words,
+//and need no sorting to keep file order (see BenchmarkScanSplit).
+func scanBlocks[T any](p *scanPool, count int, scan func(start, end int) T) []T {
+	blocks := 1
+	if p != nil {
+		blocks = p.blocks
+		if maxBlocks := count / minScanBlockWords; blocks > maxBlocks {
+			blocks = maxBlocks
+		}
+	}
+	if blocks < 2 {
+		return []T{scan(0, count)}
+	}
+
+	results := make([]T, blocks)
+	var waitGroup sync.WaitGroup
+	waitGroup.Add(blocks - 1)
+	for block := 1; block < blocks; block++ {
+		start, end, result := block*count/blocks, (block+1)*count/blocks, &results[block]
+		p.scans <- func() {
+			defer waitGroup.Done()
+			*result = scan(start, end)
+		}
+	}
+	results[0] = scan(0, count/blocks)
+	waitGroup.Wait()
+	return results
+}
+
+//nodeScan holds the result of scanning part of a dictionary for the children of a node.
+type nodeScan struct {
+	children, kept []*aStarWordNode
+}
+
+//Generate the children of a node as generateNodeChildren does, a large dictionary is split into blocks scanned on the pool's goroutines
+//and the blocks are joined in order so the result matches a single scan.
+func (p *scanPool) nodeChildren(node *aStarWordNode, dict []*aStarWordNode, moves *moveRules) (childrenNodes, newDict []*aStarWordNode) {
+	scans := scanBlocks(p, len(dict), func(start, end int) nodeScan {
+		children, kept := generateNodeChildren(node, dict[start:end], moves)
+		return nodeScan{children: children, kept: kept}
+	})
+	if len(scans) == 1 {
+		return scans[0].children, scans[0].kept
+	}
+
+	childrenNodes = make([]*aStarWordNode, 0, len(dict))
+	newDict = make([]*aStarWordNode, 0, len(dict))
+	for _, scan := range scans {
+		childrenNodes = append(childrenNodes, scan.children...)
+		newDict = append(newDict, scan.kept...)
+	}
+	return
+}
@@ -0,0 +1,11 @@
+package wordPathAnalyser
+
+//Structs used to hold the mocked input.
+type scanBlocksMockInput struct {
+	PoolWords, Count, Workers int
+	Blocks                    [][2]int
+}
+type concurrentScanMockInput struct {
+	Word  string
+	Moves *moveRules
+}
@@ -0,0 +1,260 @@
+package wordPathAnalyser
+
+import (
+	"fmt"
+	"math/rand"
+	"runtime"
+	"sort"
+	"testing"
+)
+
+//Test that a scan is only split when every goroutine has enough words, and that the blocks cover every ID in order.
+//The pool is started for the number of words in the search, each scan of the search can have fewer words.
+func TestScanBlocks(t *testing.T) {
+	fmt.Println("Testing concurrent scan method: 'scanBlocks'....")
+
+	//Arrange
+	testInputs := []scanBlocksMockInput{
+		{PoolWords: 0, Count: 0, Workers: 4, Blocks: [][2]int{{0, 0}}},
+		{PoolWords: 100, Count: 100, Workers: 4, Blocks: [][2]int{{0, 100}}},
+		{PoolWords: 4096, Count: 4096, Workers: 4, Blocks: [][2]int{{0, 2048}, {2048, 4096}}},
+		{PoolWords: 10001, Count: 10001, Workers: 4, Blocks: [][2]int{{0, 2500}, {2500, 5000}, {5000, 7500}, {7500, 10001}}},
+		{PoolWords: 10001, Count: 10001, Workers: 1, Blocks: [][2]int{{0, 10001}}},
+		{PoolWords: 10001, Count: 4096, Workers: 4, Blocks: [][2]int{{0, 2048}, {2048, 4096}}},
+		{PoolWords: 10001, Count: 100, Workers: 4, Blocks: [][2]int{{0, 100}}},
+		{PoolWords: 100, Count: 10001, Workers: 4, Blocks: [][2]int{{0, 10001}}},
+	}
+	defer runtime.GOMAXPROCS(runtime.GOMAXPROCS(0))
+
+	for i, input := range testInputs {
+		fmt.Print("Test ", i+1, " of ", len(testInputs))
+		runtime.GOMAXPROCS(input.Workers)
+
+		pool := newScanPool(input.PoolWords)
+
+		//Act
+		result := scanBlocks(pool, input.Count, func(start, end int) [2]int { return [2]int{start, end} })
+		pool.close()
+
+		//Assert
+		if fmt.Sprint(input.Blocks) != fmt.Sprint(result) {
+			t.Error(
+				"Test number ", i+1, "\n",
+				"Given the inputs:\n",
+				"pool words = ", input.PoolWords, "\n",
+				"count = ", input.Count, "\n",
+				"workers = ", input.Workers, "\n",
+				"Expected result to be:\n",
+				input.Blocks, "\n",
+				"Actual result was:\n",
+				result, "\n",
+			)
+			fmt.Println(" - failed.")
+		} else {
+			fmt.Println(" - passed.")
+		}
+	}
+	fmt.Print("\n")
+}
+
+//Test that generating children by scanning a large dictionary in blocks on the pool's goroutines matches a single scan.
+func TestScanPoolNodeChildren(t *testing.T) {
+	fmt.Println("Testing generating children nodes method with a concurrent scan: 'nodeChildren'....")
+
+	//Arrange
+	defer runtime.GOMAXPROCS(runtime.GOMAXPROCS(4))
+	dict := make([]*aStarWordNode, 0)
+	for _, word := range benchmarkWords() {
+		node := newAStarWordNode(word)
+		dict = append(dict, &node)
+	}
+	swapMoves := newMoveRules(map[string]bool{"dace": true}, MoveConstraints{LockedPositions: []int{0}}, 1)
+	swapMoves.swaps = true
+	testInputs := []concurrentScanMockInput{
+		{Word: "dead", Moves: nil},
+		{Word: "hhhh", Moves: nil},
+		{Word: "abcd", Moves: newMoveRules(nil, MoveConstraints{}, 2)},
+		{Word: "dace", Moves: swapMoves},
+	}
+	pool := newScanPool(len(dict))
+	defer pool.close()
+
+	for i, input := range testInputs {
+		fmt.Print("Test ", i+1, " of ", len(testInputs))
+		node := newAStarWordNode(input.Word)
+		expectedChildren, expectedDictionary := generateNodeChildren(&node, dict, input.Moves)
+
+		//Act
+		resultChildren, resultDictionary := pool.nodeChildren(&node, dict, input.Moves)
+
+		//Assert
+		if !doNodePointerArraysMatch(expectedChildren, resultChildren) || !doNodePointerArraysMatch(expectedDictionary, resultDictionary) {
+			t.Error(
+				"Test number ", i+1, "\n",
+				"Given the inputs:\n",
+				"word = ", input.Word, "\n",
+				"Expected results to be:\n",
+				"Children = ", nodeWords(expectedChildren), "\n",
+				"Dictionary Length = ", len(expectedDictionary), "\n",
+				"Actual results were:\n",
+				"Children = ", nodeWords(resultChildren), "\n",
+				"Dictionary Length = ", len(resultDictionary), "\n",
+			)
+			fmt.Println(" - failed.")
+		} else {
+			fmt.Println(" - passed.")
+		}
+	}
+	fmt.Print("\n")
+}
+
+//Test that the graphs built as one batch on several goroutines have the same edges as scanning each graph's words one at a time.
+func TestNewWordGraphsConcurrentBatch(t *testing.T) {
+	fmt.Println("Testing word graph batch building method: 'newWordGraphs'....")
+
+	//Arrange
+	defer runtime.GOMAXPROCS(runtime.GOMAXPROCS(4))
+	words := append(readWordList("./testInputGraph.txt", ""), benchmarkWords()...)
+	words = append(words, "a", "b", "planets", "planted", "planter")
+	testInputs := [][]string{words, readWordList("./testInputGraph.txt", ""), {}}
+
+	for i, input := range testInputs {
+		fmt.Print("Test ", i+1, " of ", len(testInputs))
+
+		//Act
+		graphs := newWordGraphs(input)
+
+		//Assert
+		expected, result := make([]string, 0), make([]string, 0)
+		for _, graph := range graphs {
+			for id, word := range graph.Words {
+				expected = append(expected, fmt.Sprint(word, scannedNeighbours(word, graph.Words)))
+				result = append(result, fmt.Sprint(word, graph.neighbourWords(id)))
+			}
+		}
+		if !doArraysMatch(expected, result) || len(expected) != len(newWordDictionaryWords(input)) {
+			t.Error(
+				"Test number ", i+1, "\n",
+				"Given the inputs:\n",
+				"words = ", len(input), "\n",
+				"Expected result to be:\n",
+				expected, "\n",
+				"Actual result was:\n",
+				result, "\n",
+			)
+			fmt.Println(" - failed.")
+		} else {
+			fmt.Println(" - passed.")
+		}
+	}
+	fmt.Print("\n")
+}
+
+//Benchmark finding a word's neighbours with the words split into blocks of IDs next to each other against the words split by wildcard bucket.
+//Each bucket holds the words that match with the first letter as a wildcard, whole buckets are given to each block in turn until it has its share of words.
+//The neighbours found by bucket are not in file order so they are sorted, the buckets are made before timing starts.
+//largest-block-% is the share of the words scanned by the largest block, the scan takes as long as its largest block.
+func BenchmarkScanSplit(b *testing.B) {
+	defer runtime.GOMAXPROCS(runtime.GOMAXPROCS(4))
+	words := skewedWords(20000, 5)
+	const blocks = 4
+	//Scan the IDs for the neighbours of the word.
+	scan := func(word string, ids []int) []int {
+		found := make([]int, 0)
+		for _, id := range ids {
+			if calculateNodeCost(word, words[id]) == 1 {
+				found = append(found, id)
+			}
+		}
+		return found
+	}
+
+	contiguous := make([][]int, blocks)
+	for block := range contiguous {
+		for id := block * len(words) / blocks; id < (block+1)*len(words)/blocks; id++ {
+			contiguous[block] = append(contiguous[block], id)
+		}
+	}
+	splits := map[string][][]int{"blocks": contiguous, "buckets": bucketBlocks(words, blocks)}
+
+	for _, name := range []string{"blocks", "buckets"} {
+		split := splits[name]
+		largest := 0
+		for _, ids := range split {
+			if len(ids) > largest {
+				largest = len(ids)
+			}
+		}
+		b.Run(name, func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				word := words[i%len(words)]
+				results := make([][]int, blocks)
+				forEachIDConcurrently(blocks, func(block int) {
+					results[block] = scan(word, split[block])
+				})
+				neighbours := make([]int, 0)
+				for _, result := range results {
+					neighbours = append(neighbours, result...)
+				}
+				if name == "buckets" {
+					sort.Ints(neighbours)
+				}
+				benchmarkResult += len(neighbours)
+			}
+			b.ReportMetric(float64(100*largest)/float64(len(words)), "largest-block-%")
+		})
+	}
+}
+
+//-----------INTERNAL FUNCTIONS-----------\\
+//Create words of the given length with the letters weighted towards the start of the alphabet, so wildcard buckets are of different sizes as in a real word list.
+func skewedWords(count, wordLength int) []string {
+	random := rand.New(rand.NewSource(1))
+	words := make([]string, count)
+	for i := range words {
+		letters := make([]byte, wordLength)
+		for j := range letters {
+			letters[j] = 'a' + byte(int(random.ExpFloat64()*4)%26)
+		}
+		words[i] = string(letters)
+	}
+	return words
+}
+
+//Split the word IDs into blocks of whole wildcard buckets (the words that match with the first letter as a wildcard), in bucket order.
+func bucketBlocks(words []string, blocks int) [][]int {
+	buckets := make(map[string][]int)
+	keys := make([]string, 0)
+	for id, word := range words {
+		if buckets[word[1:]] == nil {
+			keys = append(keys, word[1:])
+		}
+		buckets[word[1:]] = append(buckets[word[1:]], id)
+	}
+
+	split := make([][]int, blocks)
+	block := 0
+	for _, key := range keys {
+		split[block] = append(split[block], buckets[key]...)
+		if block < blocks-1 && len(split[block]) >= len(words)/blocks {
+			block++
+		}
+	}
+	return split
+}
+
+//Find the words one letter different from the word by comparing it with every word in order.
+func scannedNeighbours(word string, words []string) []string {
+	neighbours := make([]string, 0)
+	for _, other := range words {
+		if len(other) == len(word) && calculateNodeCost(word, other) == 1 {
+			neighbours = append(neighbours, other)
+		}
+	}
+	return neighbours
+}
+
+//Find the words a dictionary keeps from a list of words (empty and duplicate words are ignored).
+func newWordDictionaryWords(words []string) []string {
+	return NewWordDictionary(words).words
+}
@@ -0,0 +1,113 @@
+package wordPathAnalyser
+
+import (
+	"math/rand"
+	"sort"
+)
+
+//PuzzleOptions is used to choose the ladder puzzles that are generated.
+type PuzzleOptions struct {
+	//Length of the start and end words.
+	WordLength int
+	//Number of steps in the shortest ladder between the start and end word.
+	PathSteps int
+	//Maximum number of distinct shortest ladders a puzzle can have (**Enter 0 for no limit**)
+	MaxSolutions int
+	//Choose the most common words (see SetWordFrequencies) as start and end words first.
+	PreferCommon bool
+	//Number of puzzles to generate (**Enter 0 for 1 puzzle**)
+	Count int
+	//Seed for the random choice of words, the same seed and dictionary always give the same puzzles.
+	Seed int64
+}
+
+//LadderPuzzle holds a generated puzzle and its verified solution.
+type LadderPuzzle struct {
+	StartWord, EndWord string
+	//A shortest ladder from the start word to the end word found by the A* search.
+	Solution []string
+	//Number of distinct shortest ladders from the start word to the end word.
+	SolutionCount int
+}
+
+//GeneratePuzzles chooses start and end word pairs from the dictionary that match the puzzle options.
+//Fewer puzzles than requested are returned if the dictionary does not have enough pairs that match.
+//INPUTS: puzzle options (PuzzleOptions)
+//OUTPUT: generated puzzles ([]LadderPuzzle)
+func (d *WordDictionary) GeneratePuzzles(o PuzzleOptions) []LadderPuzzle {
+	//Number of puzzles still to be generated.
+	count := o.Count
+	if count <= 0 {
+		count = 1
+	}
+	puzzles := make([]LadderPuzzle, 0, count)
+	if o.PathSteps <= 0 {
+		return puzzles
+	}
+
+	random := rand.New(rand.NewSource(o.Seed))
+	graph := d.wordGraph(o.WordLength)
+
+	//Start words are tried in a random order (most common first if requested).
+	startWords := random.Perm(len(graph.Words))
+	if o.PreferCommon {
+		sort.SliceStable(startWords, func(i, j int) bool {
+			return d.Frequency(graph.Words[startWords[i]]) > d.Frequency(graph.Words[startWords[j]])
+		})
+	}
+	//Set of pairs already used, so that the same pair is not returned in reverse.
+	used := make(map[[2]string]bool)
+
+	for _, start := range startWords {
+		if len(puzzles) == count {
+			break
+		}
+
+		//Every word the right number of steps away that does not have too many solutions.
+		distances, ladderCounts := graph.ladderCountsFrom(start)
+		endWords := make([]int, 0)
+		for id, distance := range distances {
+			if distance == o.PathSteps && (o.MaxSolutions <= 0 || ladderCounts[id] <= o.MaxSolutions) && !used[edgeKey(graph.Words[start], graph.Words[id])] {
+				endWords = append(endWords, id)
+			}
+		}
+		if len(endWords) == 0 {
+			continue
+		}
+
+		//Choose the end word, the most common if requested.
+		end := endWords[random.Intn(len(endWords))]
+		if o.PreferCommon {
+			end = endWords[0]
+			for _, id := range endWords {
+				if d.Frequency(graph.Words[id]) > d.Frequency(graph.Words[end]) {
+					end = id
+				}
+			}
+		}
+
+		//Verify the puzzle with the A* search, the path is returned from the end word so is reversed.
+		pathFound, resultPath := d.AStarAnalyse(graph.Words[start], graph.Words[end])
+		if !pathFound || len(resultPath)-1 != o.PathSteps {
+			continue
+		}
+		used[edgeKey(graph.Words[start], graph.Words[end])] = true
+		puzzles = append(puzzles, LadderPuzzle{
+			StartWord:     graph.Words[start],
+			EndWord:       graph.Words[end],
+			Solution:      reverseWords(resultPath),
+			SolutionCount: ladderCounts[end],
+		})
+	}
+
+	return puzzles
+}
+
+//Create a copy of a list of words in reverse order.
+func reverseWords(words []string) []string {
+	reversed := make([]string, len(words))
+	for i, word := range words {
+		reversed[len(words)-1-i] = word
+	}
+	return reversed
+}
@@ -0,0 +1,8 @@
+package wordPathAnalyser
+
+//Structs used to hold the mocked input.
+type puzzleGeneratorMockInput struct {
+	Frequencies map[string]int
+	Options     PuzzleOptions
+	Result      []LadderPuzzle
+}
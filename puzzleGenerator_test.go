@@ -0,0 +1,96 @@
+package wordPathAnalyser
+
+import (
+	"fmt"
+	"testing"
+)
+
+//Test that the generated puzzles match the options and come with a valid solution.
+func TestWordDictionaryGeneratePuzzles(t *testing.T) {
+	fmt.Println("Testing puzzle generator method: 'GeneratePuzzles'....")
+
+	//Arrange
+	frequencies := map[string]int{"cold": 100, "warm": 90, "card": 50}
+	testInputs := []puzzleGeneratorMockInput{
+		{Options: PuzzleOptions{WordLength: 4, PathSteps: 6, Count: 3},
+			Result: []LadderPuzzle{
+				{StartWord: "warm", EndWord: "bolt", Solution: []string{"warm", "worm", "word", "cord", "cold", "bold", "bolt"}, SolutionCount: 3},
+			}},
+		{Options: PuzzleOptions{WordLength: 4, PathSteps: 6, MaxSolutions: 2},
+			Result: []LadderPuzzle{}},
+		{Frequencies: frequencies,
+			Options: PuzzleOptions{WordLength: 4, PathSteps: 4, Count: 2, PreferCommon: true},
+			Result: []LadderPuzzle{
+				{StartWord: "cold", EndWord: "warm", Solution: []string{"cold", "cord", "word", "worm", "warm"}, SolutionCount: 3},
+				{StartWord: "warm", EndWord: "core", Solution: []string{"warm", "ward", "card", "care", "core"}, SolutionCount: 4},
+			}},
+		{Options: PuzzleOptions{WordLength: 3, PathSteps: 3, MaxSolutions: 2, Count: 2},
+			Result: []LadderPuzzle{
+				{StartWord: "dog", EndWord: "cat", Solution: []string{"dog", "cog", "cot", "cat"}, SolutionCount: 2},
+			}},
+		{Options: PuzzleOptions{WordLength: 5, PathSteps: 1},
+			Result: []LadderPuzzle{}},
+	}
+
+	for i, input := range testInputs {
+		fmt.Print("Test ", i+1, " of ", len(testInputs))
+		dictionary := LoadWordDictionary("./testInputGraph.txt", "")
+		dictionary.SetWordFrequencies(input.Frequencies)
+		//Act
+		result := dictionary.GeneratePuzzles(input.Options)
+		repeatResult := dictionary.GeneratePuzzles(input.Options)
+
+		//Assert
+		if fmt.Sprint(input.Result) != fmt.Sprint(result) || fmt.Sprint(result) != fmt.Sprint(repeatResult) {
+			t.Error(
+				"Test number ", i+1, "\n",
+				"Given the inputs:\n",
+				"options = ", input.Options, "\n",
+				"Expected result to be:\n",
+				"Puzzles = ", input.Result, "\n",
+				"Actual result was:\n",
+				"Puzzles = ", result, "\n",
+				"Repeated Puzzles = ", repeatResult, "\n",
+			)
+			fmt.Println(" - failed.")
+		} else {
+			fmt.Println(" - passed.")
+		}
+	}
+	fmt.Print("\n")
+}
+
+//Test that generated puzzles are always valid optimal ladders of the requested length.
+func TestWordDictionaryGeneratePuzzlesAreValid(t *testing.T) {
+	fmt.Println("Testing generated puzzles are valid: 'GeneratePuzzles'....")
+
+	//Arrange
+	dictionary := LoadWordDictionary("./testInputGraph.txt", "")
+
+	for seed := int64(0); seed < 5; seed++ {
+		fmt.Print("Test ", seed+1, " of 5")
+		//Act
+		puzzles := dictionary.GeneratePuzzles(PuzzleOptions{WordLength: 4, PathSteps: 3, Count: 4, Seed: seed})
+
+		//Assert
+		passed := len(puzzles) == 4
+		for _, puzzle := range puzzles {
+			validation := ValidateLadder(puzzle.Solution, dictionary)
+			passed = passed && validation.Optimal && validation.LadderSteps == 3 &&
+				puzzle.Solution[0] == puzzle.StartWord && puzzle.Solution[3] == puzzle.EndWord
+		}
+		if !passed {
+			t.Error(
+				"Test number ", seed+1, "\n",
+				"Given the seed = ", seed, "\n",
+				"Expected 4 valid puzzles of 3 steps\n",
+				"Actual result was:\n",
+				"Puzzles = ", puzzles, "\n",
+			)
+			fmt.Println(" - failed.")
+		} else {
+			fmt.Println(" - passed.")
+		}
+	}
+	fmt.Print("\n")
+}
@@ -0,0 +1,86 @@
+package wordPathAnalyser
+
+import "regexp"
+
+//SearchGoal describes the words a search can finish on.
+type SearchGoal struct {
+	//Words (or patterns when wildcards is true) used to estimate the cost to the goal, if empty the estimate is always 0.
+	targets []string
+	//True when a ? in a target matches any letter.
+	wildcards bool
+	//Reports if a word is a goal word.
+	matches func(word string) bool
+}
+
+//GoalWord creates a goal of reaching a single word.
+func GoalWord(word string) SearchGoal {
+	return GoalWords([]string{word})
+}
+
+//GoalWords creates a goal of reaching any word in the set, the cost estimate is the lowest estimate to any of the words.
+func GoalWords(words []string) SearchGoal {
+	//Set of the goal words.
+	wordSet := make(map[string]bool, len(words))
+	for _, word := range words {
+		wordSet[word] = true
+	}
+	return SearchGoal{
+		targets: append([]string{}, words...),
+		matches: func(word string) bool { return wordSet[word] },
+	}
+}
+
+//GoalPattern creates a goal of reaching any word that matches the pattern, a ? matches any letter (for example "?a?e").
+func GoalPattern(pattern string) SearchGoal {
+	return SearchGoal{
+		targets:   []string{pattern},
+		wildcards: true,
+		matches: func(word string) bool {
+			return len(word) == len(pattern) && fillPattern(pattern, word) == word
+		},
+	}
+}
+
+//GoalRegexp creates a goal of reaching any word the regular expression matches (for example "ing$").
+//There is no cost estimate for a regular expression so the search checks the cheapest words first.
+func GoalRegexp(re *regexp.Regexp) SearchGoal {
+	return SearchGoal{matches: re.MatchString}
+}
+
+//GoalFunc creates a goal of reaching any word the function returns true for.
+//There is no cost estimate for a function so the search checks the cheapest words first.
+func GoalFunc(fn func(word string) bool) SearchGoal {
+	return SearchGoal{matches: fn}
+}
+
+//Calculate the lowest estimated cost from a word to any target of the goal using the estimate for a single target.
+func (g SearchGoal) estimate(word string, estimateTo func(word, target string) int) int {
+	//Lowest estimate found, -1 until a target the same length as the word is found.
+	result := -1
+	for _, target := range g.targets {
+		if len(target) != len(word) {
+			continue
+		}
+		if g.wildcards {
+			target = fillPattern(target, word)
+		}
+		if cost := estimateTo(word, target); result == -1 || cost < result {
+			result = cost
+		}
+	}
+	if result == -1 {
+		return 0
+	}
+	return result
+}
+
+//Replace each ? in the pattern with the letter at the same position in the word (the word must be as long as the pattern).
+func fillPattern(pattern, word string) string {
+	filled := []byte(pattern)
+	for i := range filled {
+		if filled[i] == '?' {
+			filled[i] = word[i]
+		}
+	}
+	return string(filled)
+}
@@ -0,0 +1,10 @@
+package wordPathAnalyser
+
+//Structs used to hold the mocked input.
+type searchGoalMockInput struct {
+	Goal           SearchGoal
+	GoalName       string
+	Word           string
+	ResultMatches  bool
+	ResultEstimate int
+}
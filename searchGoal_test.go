@@ -0,0 +1,53 @@
+package wordPathAnalyser
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+)
+
+//Test that each kind of goal matches the right words and estimates the letters left to change.
+func TestSearchGoal(t *testing.T) {
+	fmt.Println("Testing search goal methods: 'matches' and 'estimate'....")
+
+	//Arrange
+	testInputs := []searchGoalMockInput{
+		{Goal: GoalWord("most"), GoalName: "word most", Word: "most", ResultMatches: true, ResultEstimate: 0},
+		{Goal: GoalWord("most"), GoalName: "word most", Word: "test", ResultMatches: false, ResultEstimate: 2},
+		{Goal: GoalWords([]string{"brag", "best", "bets"}), GoalName: "words brag, best, bets", Word: "test", ResultMatches: false, ResultEstimate: 1},
+		{Goal: GoalWords([]string{"brag", "best", "bets"}), GoalName: "words brag, best, bets", Word: "bets", ResultMatches: true, ResultEstimate: 0},
+		{Goal: GoalPattern("?a?e"), GoalName: "pattern ?a?e", Word: "care", ResultMatches: true, ResultEstimate: 0},
+		{Goal: GoalPattern("?a?e"), GoalName: "pattern ?a?e", Word: "cord", ResultMatches: false, ResultEstimate: 2},
+		{Goal: GoalPattern("?a?e"), GoalName: "pattern ?a?e", Word: "cares", ResultMatches: false, ResultEstimate: 0},
+		{Goal: GoalRegexp(regexp.MustCompile("ing$")), GoalName: "regexp ing$", Word: "sing", ResultMatches: true, ResultEstimate: 0},
+		{Goal: GoalRegexp(regexp.MustCompile("ing$")), GoalName: "regexp ing$", Word: "sang", ResultMatches: false, ResultEstimate: 0},
+		{Goal: GoalFunc(func(word string) bool { return word[0] == 'w' }), GoalName: "func starts with w", Word: "warm", ResultMatches: true, ResultEstimate: 0},
+	}
+
+	for i, input := range testInputs {
+		fmt.Print("Test ", i+1, " of ", len(testInputs))
+		//Act
+		matches := input.Goal.matches(input.Word)
+		estimate := input.Goal.estimate(input.Word, calculateNodeCost)
+
+		//Assert
+		if input.ResultMatches != matches || input.ResultEstimate != estimate {
+			t.Error(
+				"Test number ", i+1, "\n",
+				"Given the inputs:\n",
+				"goal = ", input.GoalName, "\n",
+				"word = ", input.Word, "\n",
+				"Expected result to be:\n",
+				"Matches = ", input.ResultMatches, "\n",
+				"Estimate = ", input.ResultEstimate, "\n",
+				"Actual result was:\n",
+				"Matches = ", matches, "\n",
+				"Estimate = ", estimate, "\n",
+			)
+			fmt.Println(" - failed.")
+		} else {
+			fmt.Println(" - passed.")
+		}
+	}
+	fmt.Print("\n")
+}
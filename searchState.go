@@ -0,0 +1,85 @@
+package wordPathAnalyser
+
+//searchState holds the search node of every word one search has used, indexed by word ID.
+//Dictionary words use their ID in the dictionary, any other word the search uses (a goal word or a generated word) is given the next free ID.
+//Each search creates its own state and only reads the dictionary, so one dictionary can be searched by any number of goroutines at once.
+type searchState struct {
+	//The dictionary searched (nil if the search does not use a dictionary).
+	dictionary *WordDictionary
+	//Number of words in the dictionary, the first ID given to a word that is not in it.
+	dictionaryWords int
+	//ID of each word used by the search that is not in the dictionary.
+	otherIDs map[string]int
+	//Search node of each word ID, nil until the search first uses the word.
+	nodes []*aStarWordNode
+	//Nodes the search scans for children (in the order they were added).
+	scanned []*aStarWordNode
+	//Goroutines used to scan the nodes while the search runs (nil if the scans are not split).
+	pool *scanPool
+}
+
+//Create the state for a new search of a dictionary (**If no dictionary is used enter nil**)
+func newSearchState(d *WordDictionary) *searchState {
+	s := &searchState{dictionary: d, otherIDs: make(map[string]int)}
+	if d != nil {
+		s.dictionaryWords = len(d.words)
+	}
+	return s
+}
+
+//Find the ID of a word, a word that is not in the dictionary is given the next free ID the first time it is used.
+func (s *searchState) wordID(word string) int {
+	if s.dictionary != nil {
+		if id, found := s.dictionary.wordIndex[word]; found {
+			return id
+		}
+	}
+	if id, found := s.otherIDs[word]; found {
+		return id
+	}
+	s.otherIDs[word] = s.dictionaryWords + len(s.otherIDs)
+	return s.otherIDs[word]
+}
+
+//Find the search node of a word, the node is created the first time the word is used.
+func (s *searchState) node(word string) *aStarWordNode {
+	id := s.wordID(word)
+	if id >= len(s.nodes) {
+		s.nodes = append(s.nodes, make([]*aStarWordNode, id+1-len(s.nodes))...)
+	}
+	if s.nodes[id] == nil {
+		node := newAStarWordNode(word)
+		s.nodes[id] = &node
+	}
+	return s.nodes[id]
+}
+
+//Find the search node of a word if the search has already used it, nil otherwise.
+func (s *searchState) usedNode(word string) *aStarWordNode {
+	if s.dictionary != nil {
+		if id, found := s.dictionary.wordIndex[word]; found && id < len(s.nodes) {
+			return s.nodes[id]
+		}
+	}
+	if id, found := s.otherIDs[word]; found && id < len(s.nodes) {
+		return s.nodes[id]
+	}
+	return nil
+}
+
+//Add the node of every dictionary word of the given length apart from the excluded words to the nodes scanned for children (in file order).
+func (s *searchState) scanLength(wordLength int, exclude ...string) {
+	if s.dictionary == nil {
+		return
+	}
+	for _, id := range s.dictionary.idsByLength[wordLength] {
+		if word := s.dictionary.words[id]; indexOf(exclude, word) == -1 {
+			s.scanned = append(s.scanned, s.node(word))
+		}
+	}
+}
+
+//Add the node of a word to the nodes scanned for children.
+func (s *searchState) scanWord(word string) {
+	s.scanned = append(s.scanned, s.node(word))
+}
@@ -0,0 +1,11 @@
+package wordPathAnalyser
+
+//Structs used to hold the mocked input.
+type searchStateNodeMockInput struct {
+	Word string
+	ID   int
+}
+type concurrentSearchMockInput struct {
+	StartWord, EndWord string
+	Options            SearchOptions
+}
@@ -0,0 +1,127 @@
+package wordPathAnalyser
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+)
+
+//Test that a search state gives dictionary words their dictionary ID and other words the next free ID, with one node for each ID.
+func TestSearchStateNode(t *testing.T) {
+	fmt.Println("Testing search state method: 'node'....")
+
+	//Arrange
+	state := newSearchState(LoadWordDictionary("./testInputGraph.txt", ""))
+	//Each test runs in order on the same state.
+	testInputs := []searchStateNodeMockInput{
+		{Word: "cold", ID: 0},
+		{Word: "warm", ID: 4},
+		{Word: "hold", ID: 17},
+		{Word: "cold", ID: 0},
+		{Word: "cog", ID: 16},
+		{Word: "zzzz", ID: 18},
+		{Word: "hold", ID: 17},
+	}
+	//Node returned for each word the first time it was used.
+	firstNodes := make(map[string]*aStarWordNode)
+
+	for i, input := range testInputs {
+		fmt.Print("Test ", i+1, " of ", len(testInputs))
+		//Act
+		node := state.node(input.Word)
+		id := state.wordID(input.Word)
+
+		//Assert
+		if firstNodes[input.Word] == nil {
+			firstNodes[input.Word] = node
+		}
+		if id != input.ID || node.Word != input.Word || node != firstNodes[input.Word] || state.usedNode(input.Word) != node {
+			t.Error(
+				"Test number ", i+1, "\n",
+				"Given the inputs:\n",
+				"word = ", input.Word, "\n",
+				"Expected results to be:\n",
+				"ID = ", input.ID, "\n",
+				"Node = ", firstNodes[input.Word], "\n",
+				"Actual results were:\n",
+				"ID = ", id, "\n",
+				"Node = ", node, "\n",
+			)
+			fmt.Println(" - failed.")
+		} else {
+			fmt.Println(" - passed.")
+		}
+	}
+	fmt.Print("\n")
+}
+
+//Test that one dictionary searched by many goroutines at once gives the same results as searching it one query at a time (run with -race).
+func TestWordDictionaryConcurrentSearches(t *testing.T) {
+	fmt.Println("Testing dictionary method with concurrent searches: 'AStarAnalyseWithOptions'....")
+
+	//Arrange
+	dictionary := LoadWordFrequencyDictionary("./testInputFrequency.txt")
+	testInputs := []concurrentSearchMockInput{
+		{StartWord: "test", EndWord: "most"},
+		{StartWord: "test", EndWord: "mist", Options: SearchOptions{PreferCommonWords: true}},
+		{StartWord: "bust", EndWord: "lost", Options: SearchOptions{MaxLettersChanged: 2}},
+		{StartWord: "pest", EndWord: "post", Options: SearchOptions{Neighbours: NeighbourGenerate}},
+		{StartWord: "test", EndWord: "most", Options: SearchOptions{Constraints: MoveConstraints{NoRepeatPosition: true}}},
+		{StartWord: "tost", EndWord: "lost", Options: SearchOptions{AnagramMoves: true, Exclude: []string{"post"}}},
+		{StartWord: "test", EndWord: "fail"},
+	}
+	//Number of times each search is run at once.
+	const copies = 20
+	expected := make([][]string, len(testInputs))
+	for i, input := range testInputs {
+		_, expected[i] = dictionary.AStarAnalyseWithOptions(input.StartWord, input.EndWord, input.Options)
+	}
+
+	//Act
+	results := make([][][]string, len(testInputs))
+	var waitGroup sync.WaitGroup
+	for i, input := range testInputs {
+		results[i] = make([][]string, copies)
+		for c := 0; c < copies; c++ {
+			waitGroup.Add(1)
+			go func(i, c int, input concurrentSearchMockInput) {
+				defer waitGroup.Done()
+				_, results[i][c] = dictionary.AStarAnalyseWithOptions(input.StartWord, input.EndWord, input.Options)
+				//Queries that do not use the search options share the dictionary as well.
+				dictionary.Neighbours(input.StartWord)
+				dictionary.WordsWithinSteps(input.StartWord, 2)
+			}(i, c, input)
+		}
+	}
+	waitGroup.Wait()
+
+	//Assert
+	for i, input := range testInputs {
+		fmt.Print("Test ", i+1, " of ", len(testInputs))
+		//Number of searches that did not give the expected path.
+		mismatches := 0
+		for _, result := range results[i] {
+			if !doArraysMatch(expected[i], result) {
+				mismatches++
+			}
+		}
+		if mismatches != 0 {
+			t.Error(
+				"Test number ", i+1, "\n",
+				"Given the inputs:\n",
+				"start word = ", input.StartWord, "\n",
+				"end word = ", input.EndWord, "\n",
+				"options = ", input.Options, "\n",
+				"Expected results to be:\n",
+				"Result Path = ", expected[i], "\n",
+				"Actual results were:\n",
+				"Mismatched Searches = ", mismatches, " of ", copies, "\n",
+				"Result Paths = ", results[i], "\n",
+			)
+			fmt.Println(" - failed.")
+		} else {
+			fmt.Println(" - passed.")
+		}
+	}
+	fmt.Print("\n")
+}
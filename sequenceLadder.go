@@ -0,0 +1,151 @@
+package wordPathAnalyser
+
+//sequenceNode is the node used by the A* search for a sequence of any comparable element.
+type sequenceNode[T comparable] struct {
+	FScore, GScore, HScore int
+	ParentNode             *sequenceNode[T]
+	Sequence               []T
+}
+
+//sequenceSource supplies the nodes of an A* search of sequences, the end sequence must be supplied as a node like any other.
+type sequenceSource[T comparable] interface {
+	//Find the nodes 1 step from a node that have not been found before (in the order they are to be added to the open list).
+	children(node *sequenceNode[T]) []*sequenceNode[T]
+}
+
+//sequenceList is the sequenceSource of a list of nodes, a node is removed from the list once it has been found.
+type sequenceList[T comparable] struct {
+	//Nodes that have not been found yet.
+	nodes []*sequenceNode[T]
+}
+
+//AStarAnalyseSequences uses the A* Graphing Algorythm to find the shortest path between two sequences of the same length when changing one element at a time.
+//For example DNA sequences where one base changes each step, or sentences split into words where one word changes each step.
+//INPUTS: start sequence, end sequence ([]T), every sequence that can be used ([][]T) (**The start and end sequence do not need to be included**)
+//OUTPUT: path found result (Boolean), path from end sequence to start sequence ([][]T) (if no path is found or the sequences are different lengths emtpy array is returned)
+func AStarAnalyseSequences[T comparable](start, end []T, sequences [][]T) (foundResult bool, resultPath [][]T) {
+	//List of all sequences that can possibly be used.
+	dictionary := make([]*sequenceNode[T], 0, len(sequences))
+	for _, sequence := range sequences {
+		//The start and end sequence are dealt with seperately.
+		if !sequencesMatch(sequence, start) && !sequencesMatch(sequence, end) {
+			dictionary = append(dictionary, &sequenceNode[T]{Sequence: sequence})
+		}
+	}
+
+	return aStarAnalyseSequences(start, end, newSequenceList(end, dictionary))
+}
+
+//Create the sequenceList of a dictionary followed by the end sequence.
+//The dictionary must not contain the start or end sequence and its nodes are updated as the search runs so it cannot be reused.
+func newSequenceList[T comparable](end []T, dictionary []*sequenceNode[T]) *sequenceList[T] {
+	return &sequenceList[T]{nodes: append(dictionary, &sequenceNode[T]{Sequence: end})}
+}
+
+//Use the A* Graphing Algorythm to find the shortest path between the start and end sequence through the nodes supplied by the source.
+func aStarAnalyseSequences[T comparable](start, end []T, source sequenceSource[T]) (foundResult bool, resultPath [][]T) {
+	//A sequence can never change length so there is no path between sequences of different lengths.
+	if len(start) != len(end) {
+		return false, [][]T{}
+	}
+	//List of sequences that have been assigned a partentNode and are still to be analyzed
+	openList := make([]*sequenceNode[T], 0)
+	//The node that relates to the start sequence.
+	startNode := &sequenceNode[T]{Sequence: start}
+	//The current node being analyzed, the end node once the solution is found.
+	var currentNode *sequenceNode[T]
+
+	//Calculate the estimated minimum cost from start to end sequence.
+	startNode.HScore = calculateSequenceCost(start, end)
+	startNode.FScore = startNode.HScore
+	openList = append(openList, startNode)
+
+	//While there are still elements in openList continue analysis
+	for len(openList) != 0 {
+		//The best potential scores of all nodes in the open list (-1 on the first pass) and the position of the best node.
+		bestFScore, bestGScore, index := -1, -1, 0
+
+		//Find the best scored node in current openList, the lowest GScore after the best FScore is used so children are attached at the earliest point possible.
+		for i, node := range openList {
+			if bestFScore >= node.FScore || bestFScore == -1 {
+				if bestGScore >= node.GScore || bestGScore == -1 {
+					bestFScore = node.FScore
+					bestGScore = node.GScore
+					currentNode = node
+					index = i
+				}
+			}
+		}
+
+		//remove current node from openList
+		openList = append(openList[:index], openList[index+1:]...)
+
+		//If true we have found the solution
+		if sequencesMatch(currentNode.Sequence, end) {
+			foundResult = true
+			break
+		}
+
+		//G score will always be current gscore + 1 for children as they are 1 step from the previous node.
+		tempGScore := currentNode.GScore + 1
+
+		//For each node 1 step from the current node update the scores and add the node to the open list
+		for _, cN := range source.children(currentNode) {
+			if tempGScore < cN.GScore || cN.GScore == 0 {
+				cN.GScore = tempGScore
+				cN.HScore = calculateSequenceCost(cN.Sequence, end)
+				cN.FScore = cN.GScore + cN.HScore
+				cN.ParentNode = currentNode
+				openList = append(openList, cN)
+			}
+		}
+	}
+
+	resultPath = [][]T{}
+	if foundResult {
+		for node := currentNode; node != nil; node = node.ParentNode {
+			resultPath = append(resultPath, node.Sequence)
+		}
+	}
+
+	return
+}
+
+//Calculate the minimum potential cost from one sequence to another (the number of elements that are different).
+func calculateSequenceCost[T comparable](s, e []T) int {
+	result := 0
+	for i := range s {
+		if s[i] != e[i] {
+			result++
+		}
+	}
+	return result
+}
+
+//Generate all the children nodes of a node, every sequence the same length with exactly 1 element different.
+//Children are removed from the new dictionary, every other node is kept in it.
+func generateSequenceChildren[T comparable](node *sequenceNode[T], dict []*sequenceNode[T]) (childrenNodes, newDict []*sequenceNode[T]) {
+	childrenNodes = make([]*sequenceNode[T], 0, len(dict))
+	newDict = make([]*sequenceNode[T], 0, len(dict))
+
+	for _, dictNode := range dict {
+		if len(dictNode.Sequence) == len(node.Sequence) && calculateSequenceCost(node.Sequence, dictNode.Sequence) == 1 {
+			childrenNodes = append(childrenNodes, dictNode)
+		} else {
+			newDict = append(newDict, dictNode)
+		}
+	}
+
+	return
+}
+
+//Find the nodes in the list 1 step from a node and remove them from the list.
+func (l *sequenceList[T]) children(node *sequenceNode[T]) (childrenNodes []*sequenceNode[T]) {
+	childrenNodes, l.nodes = generateSequenceChildren(node, l.nodes)
+	return
+}
+
+//Check if two sequences have the same elements in the same order.
+func sequencesMatch[T comparable](a, b []T) bool {
+	return len(a) == len(b) && calculateSequenceCost(a, b) == 0
+}
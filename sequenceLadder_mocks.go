@@ -0,0 +1,13 @@
+package wordPathAnalyser
+
+//Structs used to hold the mocked input.
+type sequenceAStarAnalyseMockInput struct {
+	Start, End []string
+	Sequences  [][]string
+	PathFound  bool
+	ResultPath [][]string
+}
+type sequenceCostMockInput struct {
+	Start, End []int
+	Result     int
+}
@@ -0,0 +1,100 @@
+package wordPathAnalyser
+
+import (
+	"fmt"
+	"testing"
+)
+
+//Test the generic A* search finds the shortest ladder between sentences changing one word at a time.
+func TestAStarAnalyseSequences(t *testing.T) {
+	fmt.Println("Testing generic sequence A* method: 'AStarAnalyseSequences'....")
+
+	//Arrange
+	sentences := [][]string{
+		{"the", "cat", "sat"},
+		{"the", "cat", "ran"},
+		{"a", "cat", "sat", "down"},
+		{"the", "dog", "ran"},
+		{"a", "dog", "ran"},
+		{"a", "dog", "hid"},
+	}
+	testInputs := []sequenceAStarAnalyseMockInput{
+		{Start: []string{"the", "cat", "sat"}, End: []string{"a", "dog", "ran"}, Sequences: sentences,
+			PathFound: true, ResultPath: [][]string{{"a", "dog", "ran"}, {"the", "dog", "ran"}, {"the", "cat", "ran"}, {"the", "cat", "sat"}}},
+		{Start: []string{"the", "cat", "sat"}, End: []string{"a", "dog", "sat"}, Sequences: sentences,
+			PathFound: true, ResultPath: [][]string{{"a", "dog", "sat"}, {"a", "dog", "ran"}, {"the", "dog", "ran"}, {"the", "cat", "ran"}, {"the", "cat", "sat"}}},
+		{Start: []string{"the", "cat", "sat"}, End: []string{"a", "cat", "sat", "down"}, Sequences: sentences,
+			PathFound: false, ResultPath: [][]string{}},
+		{Start: []string{"a", "cat", "sat", "down"}, End: []string{"the", "cat", "sat"}, Sequences: sentences,
+			PathFound: false, ResultPath: [][]string{}},
+		{Start: []string{"a", "cat", "sat", "down"}, End: []string{}, Sequences: sentences,
+			PathFound: false, ResultPath: [][]string{}},
+		{Start: []string{"the", "cat", "sat"}, End: []string{"a", "bird", "flew"}, Sequences: sentences,
+			PathFound: false, ResultPath: [][]string{}},
+		{Start: []string{"the", "cat", "sat"}, End: []string{"the", "cat", "sat"}, Sequences: sentences,
+			PathFound: true, ResultPath: [][]string{{"the", "cat", "sat"}}},
+	}
+
+	for i, input := range testInputs {
+		fmt.Print("Test ", i+1, " of ", len(testInputs))
+		//Act
+		pathFound, resultPath := AStarAnalyseSequences(input.Start, input.End, input.Sequences)
+
+		//Assert
+		if pathFound != input.PathFound || fmt.Sprint(input.ResultPath) != fmt.Sprint(resultPath) {
+			t.Error(
+				"Test number ", i+1, "\n",
+				"Given the inputs:\n",
+				"start = ", input.Start, "\n",
+				"end = ", input.End, "\n",
+				"Expected results to be:\n",
+				"Path Found = ", input.PathFound, "\n",
+				"Result Path = ", input.ResultPath, "\n",
+				"Actual results were:\n",
+				"Path Found = ", pathFound, "\n",
+				"Result Path = ", resultPath, "\n",
+			)
+			fmt.Println(" - failed.")
+		} else {
+			fmt.Println(" - passed.")
+		}
+	}
+	fmt.Print("\n")
+}
+
+//Test the sequence cost is the number of elements that are different.
+func TestCalculateSequenceCost(t *testing.T) {
+	fmt.Println("Testing sequence cost calculation method: 'calculateSequenceCost'....")
+
+	//Arrange
+	testInputs := []sequenceCostMockInput{
+		{Start: []int{1, 2, 3}, End: []int{1, 2, 3}, Result: 0},
+		{Start: []int{1, 2, 3}, End: []int{1, 5, 3}, Result: 1},
+		{Start: []int{1, 2, 3}, End: []int{3, 2, 1}, Result: 2},
+		{Start: []int{1, 2, 3}, End: []int{4, 5, 6}, Result: 3},
+	}
+
+	for i, input := range testInputs {
+		fmt.Print("Test ", i+1, " of ", len(testInputs))
+		//Act
+		result := calculateSequenceCost(input.Start, input.End)
+
+		//Assert
+		if result != input.Result {
+			t.Error(
+				"Test number ", i+1, "\n",
+				"Given the inputs:\n",
+				"start = ", input.Start, "\n",
+				"end = ", input.End, "\n",
+				"Expected result to be:\n",
+				input.Result, "\n",
+				"Actual result was:\n",
+				result, "\n",
+			)
+			fmt.Println(" - failed.")
+		} else {
+			fmt.Println(" - passed.")
+		}
+	}
+	fmt.Print("\n")
+}
@@ -0,0 +1,195 @@
+package wordPathAnalyser
+
+import (
+	"log"
+	"strconv"
+	"strings"
+)
+
+//SubstitutionCostMatrix holds the cost of changing one letter into another.
+type SubstitutionCostMatrix struct {
+	//Cost of changing a letter when no cost has been set for the pair of letters.
+	defaultCost int
+	//Cost set for each pair of letters (from, to).
+	costs map[[2]byte]int
+	//Lowest cost of changing any letter into each letter, kept up to date by SetCost.
+	minCostTo [256]int
+}
+
+//NewSubstitutionCostMatrix creates a cost matrix where every change costs the default cost (a cost below 1 is raised to 1).
+func NewSubstitutionCostMatrix(defaultCost int) *SubstitutionCostMatrix {
+	defaultCost = validSubstitutionCost(defaultCost)
+	m := &SubstitutionCostMatrix{defaultCost: defaultCost, costs: make(map[[2]byte]int)}
+	for i := range m.minCostTo {
+		m.minCostTo[i] = defaultCost
+	}
+	return m
+}
+
+//LoadSubstitutionCostMatrix reads in a cost matrix file.
+//Each line is either "from to cost" (the cost of changing the letter from into the letter to) or "default cost".
+//Costs only apply in the direction given, blank lines and lines starting with # are ignored.
+//INPUTS: filelocation (string)
+//OUTPUT: the loaded cost matrix (*SubstitutionCostMatrix) (**the default cost is 1 unless set in the file**)
+func LoadSubstitutionCostMatrix(fL string) *SubstitutionCostMatrix {
+	//Default cost and the cost of each pair of letters in file order.
+	defaultCost := 1
+	pairs := make([][2]byte, 0)
+	pairCosts := make([]int, 0)
+
+	for i, line := range readWordList(fL, "") {
+		fields := strings.Fields(line)
+		if len(fields) == 0 || strings.HasPrefix(fields[0], "#") {
+			continue
+		}
+
+		//Check the line has the right number of fields and single letters then read in the cost.
+		var cost int
+		var err error
+		switch {
+		case len(fields) == 2 && fields[0] == "default":
+			cost, err = strconv.Atoi(fields[1])
+		case len(fields) == 3 && len(fields[0]) == 1 && len(fields[1]) == 1:
+			cost, err = strconv.Atoi(fields[2])
+		default:
+			log.Fatal(fL, ": line ", i+1, ": expected \"from to cost\" or \"default cost\"")
+		}
+		if err == nil && cost <= 0 {
+			err = strconv.ErrRange
+		}
+		if err != nil {
+			log.Fatal(fL, ": line ", i+1, ": ", err)
+		}
+
+		if len(fields) == 2 {
+			defaultCost = cost
+		} else {
+			pairs = append(pairs, [2]byte{fields[0][0], fields[1][0]})
+			pairCosts = append(pairCosts, cost)
+		}
+	}
+
+	m := NewSubstitutionCostMatrix(defaultCost)
+	for i, pair := range pairs {
+		m.SetCost(pair[0], pair[1], pairCosts[i])
+	}
+	return m
+}
+
+//VowelConsonantCostMatrix creates a cost matrix for lower case letters where changing a vowel to another vowel costs 1,
+//a consonant to another consonant costs 2 and a vowel to a consonant (or the other way round) costs 3.
+func VowelConsonantCostMatrix() *SubstitutionCostMatrix {
+	m := NewSubstitutionCostMatrix(2)
+	for from := byte('a'); from <= 'z'; from++ {
+		for to := byte('a'); to <= 'z'; to++ {
+			switch {
+			case isVowel(from) && isVowel(to):
+				m.SetCost(from, to, 1)
+			case isVowel(from) != isVowel(to):
+				m.SetCost(from, to, 3)
+			}
+		}
+	}
+	return m
+}
+
+//KeyboardDistanceCostMatrix creates a cost matrix for lower case letters where a change costs the number of keys
+//between the letters on a QWERTY keyboard (moving diagonally counts as one key). Other characters cost the default of 10.
+func KeyboardDistanceCostMatrix() *SubstitutionCostMatrix {
+	rows := []string{"qwertyuiop", "asdfghjkl", "zxcvbnm"}
+	m := NewSubstitutionCostMatrix(10)
+
+	for fromRow, fromKeys := range rows {
+		for fromColumn := range fromKeys {
+			for toRow, toKeys := range rows {
+				for toColumn := range toKeys {
+					distance := absInt(fromRow - toRow)
+					if columns := absInt(fromColumn - toColumn); columns > distance {
+						distance = columns
+					}
+					if distance > 0 {
+						m.SetCost(fromKeys[fromColumn], toKeys[toColumn], distance)
+					}
+				}
+			}
+		}
+	}
+	return m
+}
+
+//SetCost sets the cost of changing the letter from into the letter to (a cost below 1 is raised to 1).
+func (m *SubstitutionCostMatrix) SetCost(from, to byte, cost int) {
+	m.costs[[2]byte{from, to}] = validSubstitutionCost(cost)
+
+	//Recalculate the lowest cost into the letter as the cost set may have been raised.
+	m.minCostTo[to] = m.defaultCost
+	for pair, c := range m.costs {
+		if pair[1] == to && pair[0] != to && c < m.minCostTo[to] {
+			m.minCostTo[to] = c
+		}
+	}
+}
+
+//Cost returns the cost of changing the letter from into the letter to (0 if the letters are the same).
+func (m *SubstitutionCostMatrix) Cost(from, to byte) int {
+	if from == to {
+		return 0
+	}
+	if cost, ok := m.costs[[2]byte{from, to}]; ok {
+		return cost
+	}
+	return m.defaultCost
+}
+
+//Find the lowest cost of any letter change.
+func (m *SubstitutionCostMatrix) minCost() int {
+	result := m.defaultCost
+	for _, cost := range m.minCostTo {
+		if cost < result {
+			result = cost
+		}
+	}
+	return result
+}
+
+//Calculate the cost of changing every letter that is different between two words of the same length.
+func substitutionCost(s, e string, m *SubstitutionCostMatrix) int {
+	result := 0
+	for i := 0; i < len(s); i++ {
+		result += m.Cost(s[i], e[i])
+	}
+	return result
+}
+
+//Calculate the minimum potential cost from one word to another when letter changes are weighted.
+//Each letter that does not match must at some point be changed into the end word's letter, so the cheapest change into that letter is counted.
+func calculateSubstitutionNodeCost(s, e string, m *SubstitutionCostMatrix) int {
+	result := 0
+	for i := 0; i < len(s); i++ {
+		if s[i] != e[i] {
+			result += m.minCostTo[e[i]]
+		}
+	}
+	return result
+}
+
+//Raise a letter change cost below 1 to 1, the weighted search needs every change to cost at least 1 so its estimate is never more than the real cost.
+func validSubstitutionCost(cost int) int {
+	if cost < 1 {
+		return 1
+	}
+	return cost
+}
+
+//Check if a letter is a lower case vowel.
+func isVowel(letter byte) bool {
+	return strings.IndexByte("aeiou", letter) != -1
+}
+
+//Return the absolute value of an int.
+func absInt(i int) int {
+	if i < 0 {
+		return -i
+	}
+	return i
+}
@@ -0,0 +1,13 @@
+package wordPathAnalyser
+
+//Structs used to hold the mocked input.
+type substitutionCostMockInput struct {
+	Matrix   *SubstitutionCostMatrix
+	From, To byte
+	Result   int
+}
+type substitutionNodeCostMockInput struct {
+	StartWord, EndWord string
+	Matrix             *SubstitutionCostMatrix
+	Result             int
+}
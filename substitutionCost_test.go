@@ -0,0 +1,102 @@
+package wordPathAnalyser
+
+import (
+	"fmt"
+	"testing"
+)
+
+//Test that the cost of each letter change is read from the matrix.
+func TestSubstitutionCostMatrixCost(t *testing.T) {
+	fmt.Println("Testing substitution cost method: 'Cost'....")
+
+	//Arrange
+	fileMatrix := LoadSubstitutionCostMatrix("./testInputSubstitution.txt")
+	vowelMatrix := VowelConsonantCostMatrix()
+	keyboardMatrix := KeyboardDistanceCostMatrix()
+	//Costs below 1 are raised to 1.
+	lowCostMatrix := NewSubstitutionCostMatrix(3)
+	lowCostMatrix.SetCost('a', 'b', -2)
+	testInputs := []substitutionCostMockInput{
+		{Matrix: fileMatrix, From: 't', To: 'm', Result: 9},
+		{Matrix: fileMatrix, From: 'm', To: 't', Result: 2},
+		{Matrix: fileMatrix, From: 'p', To: 'm', Result: 1},
+		{Matrix: fileMatrix, From: 'p', To: 'p', Result: 0},
+		{Matrix: vowelMatrix, From: 'a', To: 'e', Result: 1},
+		{Matrix: vowelMatrix, From: 'b', To: 'c', Result: 2},
+		{Matrix: vowelMatrix, From: 'b', To: 'a', Result: 3},
+		{Matrix: keyboardMatrix, From: 'q', To: 'w', Result: 1},
+		{Matrix: keyboardMatrix, From: 'q', To: 's', Result: 1},
+		{Matrix: keyboardMatrix, From: 'q', To: 'p', Result: 9},
+		{Matrix: keyboardMatrix, From: 'a', To: '1', Result: 10},
+		{Matrix: NewSubstitutionCostMatrix(0), From: 'a', To: 'b', Result: 1},
+		{Matrix: NewSubstitutionCostMatrix(-4), From: 'a', To: 'b', Result: 1},
+		{Matrix: lowCostMatrix, From: 'a', To: 'b', Result: 1},
+		{Matrix: lowCostMatrix, From: 'b', To: 'a', Result: 3},
+		{Matrix: TransitionTransversionCostMatrix(0, 1), From: 'A', To: 'G', Result: 1},
+		{Matrix: TransitionTransversionCostMatrix(-1, -2), From: 'A', To: 'C', Result: 1},
+	}
+
+	for i, input := range testInputs {
+		fmt.Print("Test ", i+1, " of ", len(testInputs))
+		//Act
+		result := input.Matrix.Cost(input.From, input.To)
+
+		//Assert
+		if input.Result != result {
+			t.Error(
+				"Test number ", i+1, "\n",
+				"Given the inputs:\n",
+				"from = ", string(input.From), "\n",
+				"to = ", string(input.To), "\n",
+				"Expected result to be:\n",
+				"Result = ", input.Result, "\n",
+				"Actual result was:\n",
+				"Result = ", result, "\n",
+			)
+			fmt.Println(" - failed.")
+		} else {
+			fmt.Println(" - passed.")
+		}
+	}
+	fmt.Print("\n")
+}
+
+//Test that the weighted node cost counts the cheapest change into each letter that does not match.
+func TestCalculateSubstitutionNodeCost(t *testing.T) {
+	fmt.Println("Testing weighted node cost calculation method: 'calculateSubstitutionNodeCost'....")
+
+	//Arrange
+	fileMatrix := LoadSubstitutionCostMatrix("./testInputSubstitution.txt")
+	testInputs := []substitutionNodeCostMockInput{
+		{StartWord: "test", EndWord: "test", Matrix: fileMatrix, Result: 0},
+		{StartWord: "test", EndWord: "most", Matrix: fileMatrix, Result: 2},
+		{StartWord: "test", EndWord: "tost", Matrix: fileMatrix, Result: 1},
+		{StartWord: "most", EndWord: "test", Matrix: fileMatrix, Result: 4},
+		{StartWord: "test", EndWord: "brag", Matrix: VowelConsonantCostMatrix(), Result: 7},
+		{StartWord: "test", EndWord: "brag", Matrix: NewSubstitutionCostMatrix(0), Result: 4},
+	}
+
+	for i, input := range testInputs {
+		fmt.Print("Test ", i+1, " of ", len(testInputs))
+		//Act
+		result := calculateSubstitutionNodeCost(input.StartWord, input.EndWord, input.Matrix)
+
+		//Assert
+		if input.Result != result {
+			t.Error(
+				"Test number ", i+1, "\n",
+				"Given the inputs:\n",
+				"start word = ", input.StartWord, "\n",
+				"end word = ", input.EndWord, "\n",
+				"Expected result to be:\n",
+				"Result = ", input.Result, "\n",
+				"Actual result was:\n",
+				"Result = ", result, "\n",
+			)
+			fmt.Println(" - failed.")
+		} else {
+			fmt.Println(" - passed.")
+		}
+	}
+	fmt.Print("\n")
+}
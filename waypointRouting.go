@@ -0,0 +1,219 @@
+package wordPathAnalyser
+
+//Largest number of required words whose best order is found by checking every order, larger sets use a heuristic.
+const exactWaypointLimit = 8
+
+//AStarAnalyseWaypoints finds the lowest cost ladder that passes through each word in the order given.
+//The ladder is made of the best ladder between each pair of waypoints, so a word may appear more than once.
+//Move constraints apply to each ladder between waypoints on its own and are not checked where two ladders join,
+//for example with NoRepeatPosition the moves into and out of a waypoint may change the same position.
+//INPUTS: waypoints from start word to end word ([]string), search options (SearchOptions)
+//OUTPUT: path found result (Boolean), path from end word to start word ([]string) (if no path is found emtpy array is returned)
+func (d *WordDictionary) AStarAnalyseWaypoints(waypoints []string, o SearchOptions) (foundResult bool, resultPath []string) {
+	if len(waypoints) == 0 {
+		return false, []string{}
+	}
+
+	//The combined ladder from the start word, each ladder after the first starts on the previous ladder's last word.
+	ladder := []string{waypoints[0]}
+	for i := 1; i < len(waypoints); i++ {
+		pathFound, path, _ := d.ladderBetween(waypoints[i-1], waypoints[i], o)
+		if !pathFound {
+			return false, []string{}
+		}
+		ladder = append(ladder, path[1:]...)
+	}
+
+	return true, reverseWords(ladder)
+}
+
+//AStarAnalyseRequiredWords finds the lowest cost ladder from the start word to the end word that passes through every required word in any order.
+//Every order is checked for up to 8 required words, for more the order is found with a nearest word heuristic improved by reversing sections (2-opt).
+//As in AStarAnalyseWaypoints, move constraints are not checked where the ladders between words join.
+//INPUTS: startword, endword (strings), required words ([]string), search options (SearchOptions)
+//OUTPUT: path found result (Boolean), path from end word to start word ([]string) (if no path is found emtpy array is returned), order the required words are visited ([]string)
+func (d *WordDictionary) AStarAnalyseRequiredWords(sW, eW string, required []string, o SearchOptions) (foundResult bool, resultPath []string, order []string) {
+	//Every word to visit, the start word is first and the end word last.
+	words := append(append([]string{sW}, required...), eW)
+	//Cost of the best ladder between each pair of words (-1 if there is no ladder).
+	costs := make([][]int, len(words))
+	for i := range words {
+		costs[i] = make([]int, len(words))
+		for j := range words {
+			if i != j {
+				if pathFound, _, cost := d.ladderBetween(words[i], words[j], o); pathFound {
+					costs[i][j] = cost
+				} else {
+					costs[i][j] = -1
+				}
+			}
+		}
+	}
+
+	//Order of the required words (as indexes into words).
+	var visitOrder []int
+	if len(required) <= exactWaypointLimit {
+		visitOrder = exactWaypointOrder(costs)
+	} else {
+		visitOrder = heuristicWaypointOrder(costs)
+	}
+	if visitOrder == nil {
+		return false, []string{}, []string{}
+	}
+
+	order = make([]string, len(visitOrder))
+	waypoints := []string{sW}
+	for i, index := range visitOrder {
+		order[i] = words[index]
+		waypoints = append(waypoints, words[index])
+	}
+	foundResult, resultPath = d.AStarAnalyseWaypoints(append(waypoints, eW), o)
+	if !foundResult {
+		order = []string{}
+	}
+
+	return
+}
+
+//Find the lowest cost ladder between two words, the path is returned from the start word to the end word.
+func (d *WordDictionary) ladderBetween(sW, eW string, o SearchOptions) (foundResult bool, path []string, cost int) {
+	if len(sW) != len(eW) {
+		return false, []string{}, 0
+	}
+	goal := GoalWord(eW)
+	state := d.goalSearchState([]string{sW}, goal)
+	rules := d.searchRules(goal, o, state.scanned)
+	foundResult, resultPath := weightedAStarAnalyse([]string{sW}, state, rules)
+
+	path = reverseWords(resultPath)
+	for i := 1; i < len(path); i++ {
+		cost += rules.stepCost(path[i-1], path[i])
+	}
+	return
+}
+
+//Find the lowest cost order to visit every word between the first and last word by checking every order (Held-Karp).
+//Returns nil if there is no order where every ladder exists.
+func exactWaypointOrder(costs [][]int) []int {
+	//Number of required words, they are at indexes 1 to count.
+	count := len(costs) - 2
+	last := len(costs) - 1
+	//best[set][i] is the lowest cost of visiting the set of required words from the start word and finishing on word i+1 (-1 if not possible).
+	best := make([][]int, 1<<count)
+	previous := make([][]int, 1<<count)
+	for set := range best {
+		best[set] = make([]int, count)
+		previous[set] = make([]int, count)
+		for i := range best[set] {
+			best[set][i] = -1
+		}
+	}
+	for i := 0; i < count; i++ {
+		best[1<<i][i] = costs[0][i+1]
+	}
+
+	for set := 1; set < 1<<count; set++ {
+		for i := 0; i < count; i++ {
+			if set&(1<<i) == 0 || best[set][i] == -1 {
+				continue
+			}
+			for j := 0; j < count; j++ {
+				if set&(1<<j) != 0 || costs[i+1][j+1] == -1 {
+					continue
+				}
+				next := set | 1<<j
+				if cost := best[set][i] + costs[i+1][j+1]; best[next][j] == -1 || cost < best[next][j] {
+					best[next][j] = cost
+					previous[next][j] = i
+				}
+			}
+		}
+	}
+
+	if count == 0 {
+		if costs[0][last] == -1 {
+			return nil
+		}
+		return []int{}
+	}
+
+	//Find the best required word to finish on before the end word, then follow the previous words back.
+	full := 1<<count - 1
+	end, bestCost := -1, -1
+	for i := 0; i < count; i++ {
+		if best[full][i] != -1 && costs[i+1][last] != -1 && (bestCost == -1 || best[full][i]+costs[i+1][last] < bestCost) {
+			end, bestCost = i, best[full][i]+costs[i+1][last]
+		}
+	}
+	if end == -1 {
+		return nil
+	}
+	order := make([]int, count)
+	for set, i := full, count-1; i >= 0; i-- {
+		order[i] = end + 1
+		set, end = set&^(1<<end), previous[set][end]
+	}
+	return order
+}
+
+//Find a low cost order to visit every word between the first and last word.
+//The nearest unvisited word is chosen each time, then sections of the order are reversed while that lowers the cost (2-opt).
+//Returns nil if there is no order where every ladder exists.
+func heuristicWaypointOrder(costs [][]int) []int {
+	last := len(costs) - 1
+	visited := make([]bool, len(costs))
+	order := make([]int, 0, len(costs)-2)
+
+	for current := 0; len(order) < len(costs)-2; {
+		next := -1
+		for i := 1; i < last; i++ {
+			if !visited[i] && costs[current][i] != -1 && (next == -1 || costs[current][i] < costs[current][next]) {
+				next = i
+			}
+		}
+		if next == -1 {
+			return nil
+		}
+		visited[next] = true
+		order = append(order, next)
+		current = next
+	}
+
+	//Cost of visiting the words in an order then the end word (-1 if a ladder is missing).
+	orderCost := func(order []int) int {
+		total, current := 0, 0
+		for i := 0; i <= len(order); i++ {
+			next := last
+			if i < len(order) {
+				next = order[i]
+			}
+			if costs[current][next] == -1 {
+				return -1
+			}
+			total += costs[current][next]
+			current = next
+		}
+		return total
+	}
+
+	bestCost := orderCost(order)
+	for improved := true; improved; {
+		improved = false
+		for i := 0; i < len(order)-1; i++ {
+			for j := i + 1; j < len(order); j++ {
+				candidate := append([]int{}, order...)
+				for a, b := i, j; a < b; a, b = a+1, b-1 {
+					candidate[a], candidate[b] = candidate[b], candidate[a]
+				}
+				if cost := orderCost(candidate); cost != -1 && (bestCost == -1 || cost < bestCost) {
+					order, bestCost, improved = candidate, cost, true
+				}
+			}
+		}
+	}
+	if bestCost == -1 {
+		return nil
+	}
+
+	return order
+}
@@ -0,0 +1,20 @@
+package wordPathAnalyser
+
+//Structs used to hold the mocked input.
+type waypointAnalyseMockInput struct {
+	Waypoints  []string
+	Options    SearchOptions
+	PathFound  bool
+	ResultPath []string
+}
+type requiredWordsAnalyseMockInput struct {
+	StartWord, EndWord string
+	Required           []string
+	PathFound          bool
+	ResultPath         []string
+	ResultOrder        []string
+}
+type waypointOrderMockInput struct {
+	Costs       [][]int
+	ResultOrder []int
+}
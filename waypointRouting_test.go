@@ -0,0 +1,148 @@
+package wordPathAnalyser
+
+import (
+	"fmt"
+	"testing"
+)
+
+//Test that the ladder passes through every waypoint in order.
+func TestWordDictionaryAStarAnalyseWaypoints(t *testing.T) {
+	fmt.Println("Testing waypoint search method: 'AStarAnalyseWaypoints'....")
+
+	//Arrange
+	dictionary := LoadWordDictionary("./testInputGraph.txt", "")
+	testInputs := []waypointAnalyseMockInput{
+		{Waypoints: []string{"cold", "core", "warm"},
+			PathFound: true, ResultPath: []string{"warm", "ward", "card", "care", "core", "cord", "cold"}},
+		{Waypoints: []string{"cold", "warm"},
+			PathFound: true, ResultPath: []string{"warm", "ward", "card", "cord", "cold"}},
+		{Waypoints: []string{"cold"},
+			PathFound: true, ResultPath: []string{"cold"}},
+		{Waypoints: []string{"cold", "cat", "warm"},
+			PathFound: false, ResultPath: []string{}},
+		{Waypoints: []string{},
+			PathFound: false, ResultPath: []string{}},
+		//Each ladder only changes the first letter once, the combined ladder changes it twice in a row at gold.
+		{Waypoints: []string{"cold", "gold", "bold"}, Options: SearchOptions{Constraints: MoveConstraints{NoRepeatPosition: true}},
+			PathFound: true, ResultPath: []string{"bold", "gold", "cold"}},
+		{Waypoints: []string{"cold", "bold"}, Options: SearchOptions{Constraints: MoveConstraints{NoRepeatPosition: true}},
+			PathFound: true, ResultPath: []string{"bold", "cold"}},
+	}
+
+	for i, input := range testInputs {
+		fmt.Print("Test ", i+1, " of ", len(testInputs))
+		//Act
+		pathFound, resultPath := dictionary.AStarAnalyseWaypoints(input.Waypoints, input.Options)
+
+		//Assert
+		if pathFound != input.PathFound || !doArraysMatch(input.ResultPath, resultPath) {
+			t.Error(
+				"Test number ", i+1, "\n",
+				"Given the inputs:\n",
+				"waypoints = ", input.Waypoints, "\n",
+				"Expected results to be:\n",
+				"Path Found = ", input.PathFound, "\n",
+				"Result Path = ", input.ResultPath, "\n",
+				"Actual results were:\n",
+				"Path Found = ", pathFound, "\n",
+				"Result Path = ", resultPath, "\n",
+			)
+			fmt.Println(" - failed.")
+		} else {
+			fmt.Println(" - passed.")
+		}
+	}
+	fmt.Print("\n")
+}
+
+//Test that the best order is found for an unordered set of required words.
+func TestWordDictionaryAStarAnalyseRequiredWords(t *testing.T) {
+	fmt.Println("Testing required words search method: 'AStarAnalyseRequiredWords'....")
+
+	//Arrange
+	dictionary := LoadWordDictionary("./testInputGraph.txt", "")
+	testInputs := []requiredWordsAnalyseMockInput{
+		{StartWord: "cold", EndWord: "warm", Required: []string{"care", "bolt"},
+			PathFound:   true,
+			ResultPath:  []string{"warm", "ward", "card", "care", "card", "cord", "cold", "bold", "bolt", "bold", "cold"},
+			ResultOrder: []string{"bolt", "care"}},
+		{StartWord: "cold", EndWord: "warm", Required: []string{},
+			PathFound:   true,
+			ResultPath:  []string{"warm", "ward", "card", "cord", "cold"},
+			ResultOrder: []string{}},
+		{StartWord: "cold", EndWord: "warm", Required: []string{"cat"},
+			PathFound:   false,
+			ResultPath:  []string{},
+			ResultOrder: []string{}},
+	}
+
+	for i, input := range testInputs {
+		fmt.Print("Test ", i+1, " of ", len(testInputs))
+		//Act
+		pathFound, resultPath, order := dictionary.AStarAnalyseRequiredWords(input.StartWord, input.EndWord, input.Required, SearchOptions{})
+
+		//Assert
+		if pathFound != input.PathFound || !doArraysMatch(input.ResultPath, resultPath) || !doArraysMatch(input.ResultOrder, order) {
+			t.Error(
+				"Test number ", i+1, "\n",
+				"Given the inputs:\n",
+				"start word = ", input.StartWord, "\n",
+				"end word = ", input.EndWord, "\n",
+				"required words = ", input.Required, "\n",
+				"Expected results to be:\n",
+				"Path Found = ", input.PathFound, "\n",
+				"Result Path = ", input.ResultPath, "\n",
+				"Order = ", input.ResultOrder, "\n",
+				"Actual results were:\n",
+				"Path Found = ", pathFound, "\n",
+				"Result Path = ", resultPath, "\n",
+				"Order = ", order, "\n",
+			)
+			fmt.Println(" - failed.")
+		} else {
+			fmt.Println(" - passed.")
+		}
+	}
+	fmt.Print("\n")
+}
+
+//Test that the exact and heuristic ordering find the lowest cost order.
+func TestWaypointOrder(t *testing.T) {
+	fmt.Println("Testing waypoint order methods: 'exactWaypointOrder' and 'heuristicWaypointOrder'....")
+
+	//Arrange
+	testInputs := []waypointOrderMockInput{
+		{Costs: [][]int{{0, 5, 1, 9, 9}, {5, 0, 2, 1, 9}, {1, 2, 0, 4, 9}, {9, 1, 4, 0, 1}, {9, 9, 9, 1, 0}},
+			ResultOrder: []int{2, 1, 3}},
+		{Costs: [][]int{{0, 1, 9}, {1, 0, 1}, {9, 1, 0}},
+			ResultOrder: []int{1}},
+		{Costs: [][]int{{0, -1, 9}, {-1, 0, -1}, {9, -1, 0}},
+			ResultOrder: nil},
+	}
+
+	for i, input := range testInputs {
+		fmt.Print("Test ", i+1, " of ", len(testInputs))
+		//Act
+		exactOrder := exactWaypointOrder(input.Costs)
+		heuristicOrder := heuristicWaypointOrder(input.Costs)
+
+		//Assert
+		if fmt.Sprint(input.ResultOrder) != fmt.Sprint(exactOrder) || fmt.Sprint(input.ResultOrder) != fmt.Sprint(heuristicOrder) ||
+			(input.ResultOrder == nil) != (exactOrder == nil) || (input.ResultOrder == nil) != (heuristicOrder == nil) {
+			t.Error(
+				"Test number ", i+1, "\n",
+				"Given the inputs:\n",
+				"costs = ", input.Costs, "\n",
+				"Expected result to be:\n",
+				"Order = ", input.ResultOrder, "\n",
+				"Actual results were:\n",
+				"Exact Order = ", exactOrder, "\n",
+				"Heuristic Order = ", heuristicOrder, "\n",
+			)
+			fmt.Println(" - failed.")
+		} else {
+			fmt.Println(" - passed.")
+		}
+	}
+	fmt.Print("\n")
+}
@@ -0,0 +1,351 @@
+package wordPathAnalyser
+
+import "math"
+
+//Number of extra cost levels used for rare words when common words are preferred (unknown words have the highest level).
+const frequencyCostLevels = 10
+
+//SearchOptions is used to change how the A* search scores its path.
+type SearchOptions struct {
+	//Make each step cost more the rarer the word stepped to is (see SetWordFrequencies) so the most natural sounding ladder is found.
+	//Ties between ladders of the same cost are broken on the number of steps.
+	PreferCommonWords bool
+	//Cost of changing each letter into another (**If every letter change is to cost 1 leave as nil**)
+	Substitutions *SubstitutionCostMatrix
+	//Words that cannot be used in this search, for example words already used today (the start and end word are never excluded).
+	Exclude []string
+	//Limits on which letters can change in each step.
+	Constraints MoveConstraints
+	//Most letters that can change in a single step (**If only 1 letter is to change leave as 0**)
+	MaxLettersChanged int
+	//Make each step cost the number of letters changed rather than 1 (substitution costs are always counted per letter).
+	CostPerLetterChanged bool
+	//Allow a step to swap two letters next to each other, as in "form" to "from".
+	SwapMoves bool
+	//Allow a step to rearrange all the letters of a word, as in "stop" to "pots".
+	AnagramMoves bool
+	//How the words one step from a word are found, chosen automatically unless set (**generating is only used when each step changes a single letter**)
+	Neighbours NeighbourStrategy
+}
+
+//searchRules holds the costs and goal used by the weighted A* search.
+type searchRules struct {
+	//Cost of a step from one word to the next (must be above 0).
+	stepCost func(from, to string) int
+	//Estimated cost from a word to a single target word, this must never be more than the real cost for the best path to be found.
+	estimateTo func(word, target string) int
+	//The words the search can finish on.
+	goal SearchGoal
+	//Lowest extra cost of changing a letter that already matches and later changing it back, used by the move constraints.
+	detourCost int
+	//Words that cannot be stepped to and the constraints on each step.
+	moves *moveRules
+	//Generates every word one step from a word, when set the dictionary is not scanned (**nil to scan the dictionary**)
+	neighbours func(word string) []string
+}
+
+//AStarAnalyseWithOptions uses the A* Graphing Algorythm to find the lowest cost path between two words of the same length using the words in the dictionary.
+//INPUTS: startword, endword (strings), search options (SearchOptions)
+//OUTPUT: path found result (Boolean), path from end word to start word ([]string) (if no path is found emtpy array is returned)
+func (d *WordDictionary) AStarAnalyseWithOptions(sW, eW string, o SearchOptions) (foundResult bool, resultPath []string) {
+	if len(sW) != len(eW) {
+		return false, []string{}
+	}
+	return d.AStarAnalyseToGoal(sW, GoalWord(eW), o)
+}
+
+//AStarAnalyseToGoal uses the A* Graphing Algorythm to find the lowest cost path from a word to any word that meets the goal.
+//Goal words given by GoalWord and GoalWords do not need to be in the dictionary, all other goal words must be.
+//INPUTS: startword (string), goal (SearchGoal), search options (SearchOptions)
+//OUTPUT: path found result (Boolean), path from the goal word reached to start word ([]string) (if no path is found emtpy array is returned)
+func (d *WordDictionary) AStarAnalyseToGoal(sW string, g SearchGoal, o SearchOptions) (foundResult bool, resultPath []string) {
+	state := d.goalSearchState([]string{sW}, g)
+	return weightedAStarAnalyse([]string{sW}, state, d.searchRules(g, o, state.scanned))
+}
+
+//Create the state for a search from the start words to the goal, the search scans every dictionary word the same length as any start word
+//(apart from the start words) and every goal word not in the dictionary.
+func (d *WordDictionary) goalSearchState(startWords []string, g SearchGoal) *searchState {
+	state := newSearchState(d)
+	//Set of word lengths searched and words already added.
+	lengths := make(map[int]bool)
+	added := make(map[string]bool)
+	for _, word := range startWords {
+		added[word] = true
+	}
+
+	for _, word := range startWords {
+		if !lengths[len(word)] {
+			lengths[len(word)] = true
+			state.scanLength(len(word), startWords...)
+		}
+	}
+	if g.wildcards {
+		return state
+	}
+
+	for _, target := range g.targets {
+		if lengths[len(target)] && !d.Contains(target) && !added[target] {
+			added[target] = true
+			state.scanWord(target)
+		}
+	}
+	return state
+}
+
+//Create the search rules for the goal and options chosen, every step costs 1 unless a cost option is chosen.
+func (d *WordDictionary) searchRules(g SearchGoal, o SearchOptions, wordDictionary []*aStarWordNode) searchRules {
+	//Goal words given as targets are never excluded.
+	excluded := make(map[string]bool, len(o.Exclude))
+	for _, word := range o.Exclude {
+		if g.wildcards || indexOf(g.targets, word) == -1 {
+			excluded[word] = true
+		}
+	}
+	moves := newMoveRules(excluded, o.Constraints, o.MaxLettersChanged)
+	moves.swaps = o.SwapMoves
+	if o.AnagramMoves {
+		moves.anagrams = d.goalAnagrams(g)
+	}
+
+	//The detour is 2 extra steps however many letters a step can change, the letter changed by the detour cannot be changed back in the next step
+	//(which fixes the letters that are still different) so it needs a step of its own.
+	//The detour is not counted when letters can be rearranged as a rearrangement can fix letters that are in the wrong place.
+	detourSteps := 2
+	//Number of extra letters changed by the detour (the letter is changed and then changed back).
+	detourLetters := 2
+	if moves.rearranges() {
+		detourSteps, detourLetters = 0, 0
+	}
+	//Cost of a rearrangement step and of changing a letter into each letter (every step costs 1 unless a cost option is chosen).
+	rearrangeCost := 1
+	letterCost := func(letter byte) int { return 1 }
+	//Lowest potential number of steps from a word to a target.
+	stepsTo := func(word, target string) int { return calculateMultiLetterNodeCost(word, target, moves.maxChanged) }
+	if moves.rearranges() {
+		stepsTo = func(word, target string) int {
+			return calculateRearrangeNodeCost(word, target, moves.maxChanged, letterCost, 1)
+		}
+	}
+
+	rules := searchRules{
+		stepCost:   func(from, to string) int { return 1 },
+		estimateTo: stepsTo,
+		goal:       g,
+		detourCost: detourSteps,
+	}
+	switch {
+	case o.Substitutions != nil:
+		rules.stepCost = func(from, to string) int { return substitutionCost(from, to, o.Substitutions) }
+		rules.estimateTo = func(word, target string) int { return calculateSubstitutionNodeCost(word, target, o.Substitutions) }
+		rules.detourCost = detourLetters * o.Substitutions.minCost()
+		rearrangeCost = o.Substitutions.minCost()
+		if moves.rearranges() {
+			rules.estimateTo = func(word, target string) int {
+				return calculateRearrangeNodeCost(word, target, 1, func(letter byte) int { return o.Substitutions.minCostTo[letter] }, rearrangeCost)
+			}
+		}
+	case o.CostPerLetterChanged:
+		rules.stepCost = calculateNodeCost
+		rules.estimateTo = calculateNodeCost
+		rules.detourCost = detourLetters
+		if moves.rearranges() {
+			rules.estimateTo = func(word, target string) int {
+				return calculateRearrangeNodeCost(word, target, 1, letterCost, rearrangeCost)
+			}
+		}
+	}
+	if moves.rearranges() {
+		rules.stepCost = rearrangeStepCost(rules.stepCost, rearrangeCost, moves)
+	}
+	if o.PreferCommonWords {
+		rules = d.commonWordRules(wordDictionary, rules, stepsTo, detourSteps)
+	}
+	rules.moves = moves
+	if !moves.rearranges() && moves.maxChanged == 1 {
+		//A graph loaded from an adjacency cache is used unless a strategy is chosen.
+		if o.Neighbours == NeighbourAuto {
+			rules.neighbours = d.graphNeighbours(wordDictionary)
+		}
+		if rules.neighbours == nil {
+			rules.neighbours = candidateNeighbours(o.Neighbours, wordDictionary)
+		}
+	}
+	return rules
+}
+
+//Add a penalty for how rare the word stepped to is to the cost of each step.
+//The number of steps is added to the cost with a smaller unit so it is only used to break ties.
+//stepsTo is the lowest potential number of steps from a word to a target, and the detour forced by the move constraints takes the given number of extra steps.
+func (d *WordDictionary) commonWordRules(wordDictionary []*aStarWordNode, letterRules searchRules, stepsTo func(word, target string) int, detourSteps int) searchRules {
+	//Cost unit of the letter and word costs, this is more than the longest possible path so steps can never outweigh a penalty.
+	unit := len(wordDictionary) + 2
+	//Penalty for stepping to a word.
+	wordPenalty := func(word string) int {
+		return int(math.Round(d.rarity(word) * frequencyCostLevels))
+	}
+
+	//Lowest penalty of stepping to any word.
+	minPenalty := frequencyCostLevels
+	for _, node := range wordDictionary {
+		if penalty := wordPenalty(node.Word); penalty < minPenalty {
+			minPenalty = penalty
+		}
+	}
+
+	return searchRules{
+		stepCost: func(from, to string) int {
+			return (letterRules.stepCost(from, to)+wordPenalty(to))*unit + 1
+		},
+		estimateTo: func(word, target string) int {
+			//Each letter still to change needs a step, the last of which is always to the target.
+			steps := stepsTo(word, target)
+			if steps == 0 {
+				return 0
+			}
+			//The penalty of the target is only known when it is a whole word rather than a filled in pattern.
+			endPenalty := minPenalty
+			if !letterRules.goal.wildcards {
+				endPenalty = wordPenalty(target)
+			}
+			return (letterRules.estimateTo(word, target)+(steps-1)*minPenalty+endPenalty)*unit + steps
+		},
+		goal:       letterRules.goal,
+		detourCost: letterRules.detourCost*unit + detourSteps*(minPenalty*unit+1),
+	}
+}
+
+//Estimate the cost from a node to the nearest goal word, including the extra cost forced by the move constraints.
+func (r searchRules) estimate(node *aStarWordNode) int {
+	//Word before the node (empty for a start node).
+	previous := ""
+	if node.ParentNode != nil {
+		previous = node.ParentNode.Word
+	}
+	return r.goal.estimate(node.Word, func(word, target string) int {
+		return r.moves.constrainedEstimate(word, target, previous, r.estimateTo(word, target), r.detourCost)
+	})
+}
+
+//Check if the last positions changed are part of the search state, a word reached by changing different positions can then have different children.
+func (r searchRules) tracksLastPosition() bool {
+	return r.moves != nil && r.moves.constraints.NoRepeatPosition
+}
+
+//Find the nodes one step from a node, either by scanning the search's dictionary nodes or from the generated neighbours.
+//Nodes for generated neighbours are created in the search state the first time they are found.
+func (r searchRules) children(node *aStarWordNode, state *searchState) []*aStarWordNode {
+	if r.neighbours == nil {
+		childrenNodes, _ := state.pool.nodeChildren(node, state.scanned, r.moves)
+		return append(childrenNodes, r.moves.anagramChildren(node, childrenNodes, state.usedNode)...)
+	}
+
+	childrenNodes := make([]*aStarWordNode, 0)
+	for _, word := range r.neighbours(node.Word) {
+		if r.moves.isExcluded(word) || !r.moves.allows(node, word) {
+			continue
+		}
+		childrenNodes = append(childrenNodes, state.node(word))
+	}
+	return childrenNodes
+}
+
+//positionState is a word and the positions changed to reach it.
+type positionState struct {
+	word      string
+	positions string
+}
+
+//Use the A* Graphing Algorythm to find the lowest cost path from any start word to a goal word through the word nodes in the search state.
+//Unlike aStarAnalyse a word's score is updated if a cheaper path to it is found later, so steps can have different costs.
+//The scanned nodes must not include the start words and the state is updated as the search runs so it cannot be reused.
+//When the rules generate neighbours nothing needs to be scanned.
+//Words that can never reach the goal under the move constraints are not added to the open list.
+func weightedAStarAnalyse(startWords []string, state *searchState, rules searchRules) (foundResult bool, resultPath []string) {
+	//The node the search finished on.
+	var goalNode *aStarWordNode
+	//List of words that have been scored and are still to be analyzed.
+	openList := make([]*aStarWordNode, 0, len(startWords))
+	//Set of the words in the open list.
+	inOpenList := make(map[*aStarWordNode]bool)
+	//Set of the words that have been given a score.
+	scored := make(map[*aStarWordNode]bool)
+	//Node for each word and the positions changed to reach it, only used when the last position changed is part of the search state.
+	positionNodes := make(map[positionState]*aStarWordNode)
+	//The scanned nodes are split across the same goroutines for every scan of the search.
+	state.pool = newScanPool(len(state.scanned))
+	defer state.pool.close()
+
+	//Add a node for each start word to the open list, each has a path cost of 0.
+	for _, sW := range startWords {
+		//A start node is only kept in the state when the neighbours are generated, as the start words are never scanned.
+		node := newAStarWordNode(sW)
+		startNode := &node
+		if rules.neighbours != nil {
+			startNode = state.node(sW)
+		}
+		startNode.HScore = rules.estimate(startNode)
+		if startNode.HScore >= unreachableCost {
+			continue
+		}
+		startNode.FScore = startNode.HScore
+		openList = append(openList, startNode)
+		inOpenList[startNode] = true
+		scored[startNode] = true
+	}
+
+	for len(openList) != 0 {
+		//Find the lowest scored node in the open list and remove it.
+		index := 0
+		for i, node := range openList {
+			if node.FScore < openList[index].FScore || (node.FScore == openList[index].FScore && node.GScore > openList[index].GScore) {
+				index = i
+			}
+		}
+		currentNode := openList[index]
+		openList = append(openList[:index], openList[index+1:]...)
+		inOpenList[currentNode] = false
+
+		//If true we have found the solution
+		if rules.goal.matches(currentNode.Word) {
+			foundResult = true
+			goalNode = currentNode
+			break
+		}
+
+		//Score every word 1 step from the current node, the dictionary is not reduced as a cheaper path to a word may still be found.
+		for _, cN := range rules.children(currentNode, state) {
+			if rules.tracksLastPosition() {
+				key := positionState{word: cN.Word, positions: changedPositions(currentNode.Word, cN.Word)}
+				if positionNodes[key] == nil {
+					node := newAStarWordNode(cN.Word)
+					positionNodes[key] = &node
+				}
+				cN = positionNodes[key]
+			}
+			tempGScore := currentNode.GScore + rules.stepCost(currentNode.Word, cN.Word)
+			if !scored[cN] || tempGScore < cN.GScore {
+				scored[cN] = true
+				cN.GScore = tempGScore
+				cN.ParentNode = currentNode
+				cN.HScore = rules.estimate(cN)
+				if cN.HScore >= unreachableCost {
+					continue
+				}
+				cN.FScore = cN.GScore + cN.HScore
+				if !inOpenList[cN] {
+					inOpenList[cN] = true
+					openList = append(openList, cN)
+				}
+			}
+		}
+	}
+
+	if foundResult {
+		resultPath = getResultPath(*goalNode)
+	} else {
+		resultPath = []string{}
+	}
+
+	return
+}
@@ -0,0 +1,16 @@
+package wordPathAnalyser
+
+//Structs used to hold the mocked input.
+type weightedAStarAnalyseMockInput struct {
+	StartWord, EndWord string
+	Options            SearchOptions
+	PathFound          bool
+	ResultPath         []string
+}
+type goalAStarAnalyseMockInput struct {
+	StartWord  string
+	Goal       SearchGoal
+	GoalName   string
+	PathFound  bool
+	ResultPath []string
+}
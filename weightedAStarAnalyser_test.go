@@ -0,0 +1,122 @@
+package wordPathAnalyser
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+)
+
+//Test that the search options change the path found by the A* search.
+func TestWordDictionaryAStarAnalyseWithOptions(t *testing.T) {
+	fmt.Println("Testing dictionary weighted A* method: 'AStarAnalyseWithOptions'....")
+
+	//Arrange
+	dictionary := LoadWordFrequencyDictionary("./testInputFrequency.txt")
+	testInputs := []weightedAStarAnalyseMockInput{
+		{StartWord: "test", EndWord: "most", Options: SearchOptions{},
+			PathFound: true, ResultPath: []string{"most", "tost", "test"}},
+		{StartWord: "test", EndWord: "most", Options: SearchOptions{PreferCommonWords: true},
+			PathFound: true, ResultPath: []string{"most", "post", "pest", "test"}},
+		{StartWord: "best", EndWord: "mist", Options: SearchOptions{},
+			PathFound: true, ResultPath: []string{"mist", "must", "bust", "best"}},
+		{StartWord: "best", EndWord: "mist", Options: SearchOptions{PreferCommonWords: true},
+			PathFound: true, ResultPath: []string{"mist", "most", "post", "pest", "best"}},
+		{StartWord: "test", EndWord: "most", Options: SearchOptions{Substitutions: LoadSubstitutionCostMatrix("./testInputSubstitution.txt")},
+			PathFound: true, ResultPath: []string{"most", "post", "tost", "test"}},
+		{StartWord: "test", EndWord: "most", Options: SearchOptions{Substitutions: VowelConsonantCostMatrix()},
+			PathFound: true, ResultPath: []string{"most", "tost", "test"}},
+		{StartWord: "test", EndWord: "most", Options: SearchOptions{Exclude: []string{"tost"}},
+			PathFound: true, ResultPath: []string{"most", "post", "pest", "test"}},
+		{StartWord: "test", EndWord: "most", Options: SearchOptions{Exclude: []string{"tost", "pest", "most"}},
+			PathFound: true, ResultPath: []string{"most", "must", "bust", "best", "test"}},
+		{StartWord: "test", EndWord: "most", Options: SearchOptions{MaxLettersChanged: 2},
+			PathFound: true, ResultPath: []string{"most", "test"}},
+		{StartWord: "test", EndWord: "mist", Options: SearchOptions{MaxLettersChanged: 2, PreferCommonWords: true},
+			PathFound: true, ResultPath: []string{"mist", "test"}},
+		{StartWord: "test", EndWord: "fail", Options: SearchOptions{MaxLettersChanged: 2},
+			PathFound: false, ResultPath: []string{}},
+		{StartWord: "best", EndWord: "lost", Options: SearchOptions{CostPerLetterChanged: true},
+			PathFound: true, ResultPath: []string{"lost", "tost", "test", "best"}},
+		{StartWord: "test", EndWord: "test", Options: SearchOptions{PreferCommonWords: true},
+			PathFound: true, ResultPath: []string{"test"}},
+		{StartWord: "test", EndWord: "fail", Options: SearchOptions{PreferCommonWords: true},
+			PathFound: false, ResultPath: []string{}},
+		{StartWord: "test", EndWord: "tests", Options: SearchOptions{},
+			PathFound: false, ResultPath: []string{}},
+	}
+
+	for i, input := range testInputs {
+		fmt.Print("Test ", i+1, " of ", len(testInputs))
+		//Act
+		pathFound, resultPath := dictionary.AStarAnalyseWithOptions(input.StartWord, input.EndWord, input.Options)
+
+		//Assert
+		if pathFound != input.PathFound || !doArraysMatch(input.ResultPath, resultPath) {
+			t.Error(
+				"Test number ", i+1, "\n",
+				"Given the inputs:\n",
+				"start word = ", input.StartWord, "\n",
+				"end word = ", input.EndWord, "\n",
+				"options = ", input.Options, "\n",
+				"Expected results to be:\n",
+				"Path Found = ", input.PathFound, "\n",
+				"Result Path = ", input.ResultPath, "\n",
+				"Actual results were:\n",
+				"Path Found = ", pathFound, "\n",
+				"Result Path = ", resultPath, "\n",
+			)
+			fmt.Println(" - failed.")
+		} else {
+			fmt.Println(" - passed.")
+		}
+	}
+	fmt.Print("\n")
+}
+
+//Test that the search finishes on the nearest word that meets the goal.
+func TestWordDictionaryAStarAnalyseToGoal(t *testing.T) {
+	fmt.Println("Testing dictionary goal A* method: 'AStarAnalyseToGoal'....")
+
+	//Arrange
+	dictionary := LoadWordDictionary("./testInputGraph.txt", "")
+	testInputs := []goalAStarAnalyseMockInput{
+		{StartWord: "cold", Goal: GoalPattern("?ar?"), GoalName: "pattern ?ar?",
+			PathFound: true, ResultPath: []string{"card", "cord", "cold"}},
+		{StartWord: "cold", Goal: GoalWords([]string{"warm", "bolt", "care"}), GoalName: "words warm, bolt, care",
+			PathFound: true, ResultPath: []string{"bolt", "bold", "cold"}},
+		{StartWord: "cold", Goal: GoalWords([]string{"hold"}), GoalName: "words hold",
+			PathFound: true, ResultPath: []string{"hold", "cold"}},
+		{StartWord: "cold", Goal: GoalRegexp(regexp.MustCompile("^w")), GoalName: "regexp ^w",
+			PathFound: true, ResultPath: []string{"word", "cord", "cold"}},
+		{StartWord: "cold", Goal: GoalFunc(func(word string) bool { return word == "worm" }), GoalName: "func worm",
+			PathFound: true, ResultPath: []string{"worm", "word", "cord", "cold"}},
+		{StartWord: "cold", Goal: GoalPattern("?og"), GoalName: "pattern ?og",
+			PathFound: false, ResultPath: []string{}},
+	}
+
+	for i, input := range testInputs {
+		fmt.Print("Test ", i+1, " of ", len(testInputs))
+		//Act
+		pathFound, resultPath := dictionary.AStarAnalyseToGoal(input.StartWord, input.Goal, SearchOptions{})
+
+		//Assert
+		if pathFound != input.PathFound || !doArraysMatch(input.ResultPath, resultPath) {
+			t.Error(
+				"Test number ", i+1, "\n",
+				"Given the inputs:\n",
+				"start word = ", input.StartWord, "\n",
+				"goal = ", input.GoalName, "\n",
+				"Expected results to be:\n",
+				"Path Found = ", input.PathFound, "\n",
+				"Result Path = ", input.ResultPath, "\n",
+				"Actual results were:\n",
+				"Path Found = ", pathFound, "\n",
+				"Result Path = ", resultPath, "\n",
+			)
+			fmt.Println(" - failed.")
+		} else {
+			fmt.Println(" - passed.")
+		}
+	}
+	fmt.Print("\n")
+}
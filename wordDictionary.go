@@ -0,0 +1,238 @@
+package wordPathAnalyser
+
+import (
+	"log"
+	"strconv"
+	"strings"
+)
+
+//WordDictionary holds a list of words loaded once so that it can be queried many times.
+//Queries never modify the dictionary, each search keeps its own state so a dictionary can be queried by any number of goroutines at once.
+type WordDictionary struct {
+	//Every word in the dictionary, the position of a word is its ID (in file order).
+	words []string
+	//ID of every word in the dictionary grouped by word length (in file order).
+	idsByLength map[int][]int
+	//ID of every word in the dictionary.
+	wordIndex map[string]int
+	//Every letter used by the dictionary words (in byte order).
+	alphabet []byte
+	//Words in the dictionary keyed by their letters in sorted order (in file order).
+	anagrams map[string][]string
+	//How common each word is (words not in the map have a frequency of 0).
+	frequencies map[string]int
+	//Frequency of the most common word.
+	maxFrequency int
+	//Word graph for each word length, only set when the dictionary is loaded with an adjacency cache.
+	graphs map[int]*wordGraph
+}
+
+//LoadWordDictionary reads in a word file and creates a dictionary from it.
+//INPUTS: filelocation, delimiter (strings) (**If delimiter is not to be used enter ""**)
+//OUTPUT: the loaded dictionary (*WordDictionary)
+func LoadWordDictionary(fL, dL string) *WordDictionary {
+	return NewWordDictionary(readWordList(fL, dL))
+}
+
+//LoadWordDictionaryWithBlocklist reads in a word file and creates a dictionary from it, leaving out every word in the blocklist file.
+//INPUTS: filelocation, delimiter, blocklist filelocation (strings) (**The delimiter is used for both files, if it is not to be used enter ""**)
+//OUTPUT: the loaded dictionary (*WordDictionary)
+func LoadWordDictionaryWithBlocklist(fL, dL, bL string) *WordDictionary {
+	return NewWordDictionaryWithBlocklist(readWordList(fL, dL), readWordList(bL, dL))
+}
+
+//LoadWordFrequencyDictionary reads in a word file where each line is a word and how common it is separated by a tab (word<TAB>count).
+//Lines without a tab are added with a count of 0, a count below 0 is an error.
+//INPUTS: filelocation (string)
+//OUTPUT: the loaded dictionary with its word frequencies set (*WordDictionary)
+func LoadWordFrequencyDictionary(fL string) *WordDictionary {
+	//Words in file order and the count for each.
+	words := make([]string, 0)
+	frequencies := make(map[string]int)
+
+	for i, line := range readWordList(fL, "") {
+		fields := strings.SplitN(line, "\t", 2)
+		words = append(words, fields[0])
+		if len(fields) == 2 {
+			count, err := strconv.Atoi(strings.TrimSpace(fields[1]))
+			if err == nil && count < 0 {
+				err = strconv.ErrRange
+			}
+			if err != nil {
+				log.Fatal(fL, ": line ", i+1, ": ", err)
+			}
+			frequencies[fields[0]] = count
+		}
+	}
+
+	d := NewWordDictionary(words)
+	d.SetWordFrequencies(frequencies)
+	return d
+}
+
+//NewWordDictionary creates a dictionary from a list of words, empty and duplicate words are ignored.
+func NewWordDictionary(words []string) *WordDictionary {
+	return NewWordDictionaryWithBlocklist(words, nil)
+}
+
+//NewWordDictionaryWithBlocklist creates a dictionary from a list of words leaving out every blocked word, empty and duplicate words are ignored.
+func NewWordDictionaryWithBlocklist(words, blocked []string) *WordDictionary {
+	//Set of words that are never added.
+	blocklist := make(map[string]bool, len(blocked))
+	for _, word := range blocked {
+		blocklist[word] = true
+	}
+
+	d := &WordDictionary{
+		words:       make([]string, 0, len(words)),
+		idsByLength: make(map[int][]int),
+		wordIndex:   make(map[string]int, len(words)),
+		anagrams:    make(map[string][]string),
+		frequencies: make(map[string]int),
+	}
+
+	for _, word := range words {
+		if _, found := d.wordIndex[word]; word == "" || found || blocklist[word] {
+			continue
+		}
+		d.wordIndex[word] = len(d.words)
+		d.idsByLength[len(word)] = append(d.idsByLength[len(word)], len(d.words))
+		d.words = append(d.words, word)
+		d.anagrams[sortedLetters(word)] = append(d.anagrams[sortedLetters(word)], word)
+	}
+
+	d.alphabet = alphabetOf(d.words)
+	return d
+}
+
+//Contains reports if the word is in the dictionary.
+func (d *WordDictionary) Contains(word string) bool {
+	_, found := d.wordIndex[word]
+	return found
+}
+
+//SetWordFrequencies sets how common each word is (for example the number of times it appears in a corpus).
+//Words not in the map are given a frequency of 0, as are words with a frequency below 0. This should be called before the dictionary is queried.
+func (d *WordDictionary) SetWordFrequencies(f map[string]int) {
+	d.frequencies = make(map[string]int, len(f))
+	d.maxFrequency = 0
+	for word, frequency := range f {
+		if frequency < 0 {
+			frequency = 0
+		}
+		d.frequencies[word] = frequency
+		if frequency > d.maxFrequency {
+			d.maxFrequency = frequency
+		}
+	}
+}
+
+//Frequency returns how common a word is (0 if it is not known).
+func (d *WordDictionary) Frequency(word string) int {
+	return d.frequencies[word]
+}
+
+//AStarAnalyse uses the A* Graphing Algorythm to find the shortest path between two words of the same length using the words in the dictionary.
+//INPUTS: startword, endword (strings)
+//OUTPUT: path found result (Boolean), path from end word to start word ([]string) (if no path is found emtpy array is returned)
+func (d *WordDictionary) AStarAnalyse(sW, eW string) (foundResult bool, resultPath []string) {
+	if len(sW) != len(eW) {
+		return false, []string{}
+	}
+	return aStarAnalyse(sW, eW, d.searchNodes(len(sW), sW, eW))
+}
+
+//Neighbours returns every dictionary word that is one letter change away from the given word (in file order).
+//The word does not need to be in the dictionary itself.
+func (d *WordDictionary) Neighbours(word string) []string {
+	if graph := d.graphs[len(word)]; graph != nil && graph.wordID(word) != -1 {
+		return graph.neighbourWords(graph.wordID(word))
+	}
+	if chooseNeighbourStrategy(NeighbourAuto, len(d.idsByLength[len(word)]), len(d.alphabet), len(word)) == NeighbourGenerate {
+		return generateCandidateNeighbours(word, d.alphabet, d.Contains, func(word string) int { return d.wordIndex[word] })
+	}
+	//The words are scanned in blocks which are joined in order.
+	ids := d.idsByLength[len(word)]
+	pool := newScanPool(len(ids))
+	defer pool.close()
+	neighbours := make([]string, 0)
+	for _, block := range scanBlocks(pool, len(ids), func(start, end int) []string {
+		found := make([]string, 0)
+		for _, id := range ids[start:end] {
+			if calculateNodeCost(word, d.words[id]) == 1 {
+				found = append(found, d.words[id])
+			}
+		}
+		return found
+	}) {
+		neighbours = append(neighbours, block...)
+	}
+	return neighbours
+}
+
+//WordsWithinSteps returns every dictionary word that can be reached from the given word in at most the given number of steps.
+//The result is grouped by distance, the index is the number of steps so index 0 only holds the word itself.
+//Distances with no words are not included at the end of the result.
+func (d *WordDictionary) WordsWithinSteps(word string, steps int) [][]string {
+	//Words still to be reached, generateNodeChildren removes each word from this list once it has been reached.
+	remaining := d.searchNodes(len(word), word)
+	pool := newScanPool(len(remaining))
+	defer pool.close()
+
+	startNode := newAStarWordNode(word)
+	//The words found at the current distance.
+	frontier := []*aStarWordNode{&startNode}
+	result := [][]string{{word}}
+
+	for step := 1; step <= steps && len(frontier) != 0; step++ {
+		next := make([]*aStarWordNode, 0)
+		for _, node := range frontier {
+			var children []*aStarWordNode
+			children, remaining = pool.nodeChildren(node, remaining, nil)
+			next = append(next, children...)
+		}
+		if len(next) != 0 {
+			result = append(result, nodeWords(next))
+		}
+		frontier = next
+	}
+
+	return result
+}
+
+//Create the search nodes for every dictionary word of the given length apart from the excluded words, for a search that needs no other state.
+func (d *WordDictionary) searchNodes(wordLength int, exclude ...string) []*aStarWordNode {
+	state := newSearchState(d)
+	state.scanLength(wordLength, exclude...)
+	return state.scanned
+}
+
+//Find every dictionary word of the given length (in file order).
+func (d *WordDictionary) wordsOfLength(wordLength int) []string {
+	words := make([]string, len(d.idsByLength[wordLength]))
+	for i, id := range d.idsByLength[wordLength] {
+		words[i] = d.words[id]
+	}
+	return words
+}
+
+//Create the word graph for all dictionary words of the given length.
+func (d *WordDictionary) wordGraph(wordLength int) *wordGraph {
+	if graph := d.graphs[wordLength]; graph != nil {
+		return graph
+	}
+	graphs := newWordGraphs(d.wordsOfLength(wordLength))
+	if len(graphs) == 0 {
+		return &wordGraph{WordLength: wordLength, Words: []string{}, Edges: [][]int{}}
+	}
+	return graphs[0]
+}
+
+//Convert a list of word nodes into a list of their words.
+func nodeWords(nodes []*aStarWordNode) []string {
+	words := make([]string, len(nodes))
+	for i, node := range nodes {
+		words[i] = node.Word
+	}
+	return words
+}
@@ -0,0 +1,16 @@
+package wordPathAnalyser
+
+//Structs used to hold the mocked input.
+type wordDictionaryNeighboursMockInput struct {
+	Word   string
+	Result []string
+}
+type wordDictionaryWordsWithinStepsMockInput struct {
+	Word   string
+	Steps  int
+	Result [][]string
+}
+type frequencyFileMockInput struct {
+	Contents string
+	Error    string
+}
@@ -0,0 +1,254 @@
+package wordPathAnalyser
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+//Test that every word one step away is returned and that the dictionary is not changed by the query.
+func TestWordDictionaryNeighbours(t *testing.T) {
+	fmt.Println("Testing dictionary neighbours method: 'Neighbours'....")
+
+	//Arrange
+	dictionary := LoadWordDictionary("./testInputGraph.txt", "")
+	testInputs := []wordDictionaryNeighboursMockInput{
+		{Word: "cold", Result: []string{"cord", "gold", "bold"}},
+		{Word: "cord", Result: []string{"cold", "card", "word", "core"}},
+		{Word: "cold", Result: []string{"cord", "gold", "bold"}},
+		{Word: "cat", Result: []string{"cot"}},
+		{Word: "hold", Result: []string{"cold", "gold", "bold"}},
+		{Word: "fail", Result: []string{}},
+		{Word: "longer", Result: []string{}},
+	}
+
+	for i, input := range testInputs {
+		fmt.Print("Test ", i+1, " of ", len(testInputs))
+		//Act
+		result := dictionary.Neighbours(input.Word)
+
+		//Assert
+		if !doArraysMatch(input.Result, result) {
+			t.Error(
+				"Test number ", i+1, "\n",
+				"Given the inputs:\n",
+				"word = ", input.Word, "\n",
+				"Expected result to be:\n",
+				"Neighbours = ", input.Result, "\n",
+				"Actual result was:\n",
+				"Neighbours = ", result, "\n",
+			)
+			fmt.Println(" - failed.")
+		} else {
+			fmt.Println(" - passed.")
+		}
+	}
+	fmt.Print("\n")
+}
+
+//Test that every word within the number of steps is returned grouped by distance.
+func TestWordDictionaryWordsWithinSteps(t *testing.T) {
+	fmt.Println("Testing dictionary radius method: 'WordsWithinSteps'....")
+
+	//Arrange
+	dictionary := LoadWordDictionary("./testInputGraph.txt", "")
+	testInputs := []wordDictionaryWordsWithinStepsMockInput{
+		{Word: "cold", Steps: 2,
+			Result: [][]string{{"cold"}, {"cord", "gold", "bold"}, {"card", "word", "core", "bolt"}}},
+		{Word: "cat", Steps: 10,
+			Result: [][]string{{"cat"}, {"cot"}, {"dot", "cog"}, {"dog"}}},
+		{Word: "cold", Steps: 0,
+			Result: [][]string{{"cold"}}},
+		{Word: "fail", Steps: 3,
+			Result: [][]string{{"fail"}}},
+	}
+
+	for i, input := range testInputs {
+		fmt.Print("Test ", i+1, " of ", len(testInputs))
+		//Act
+		result := dictionary.WordsWithinSteps(input.Word, input.Steps)
+
+		//Assert
+		if fmt.Sprint(input.Result) != fmt.Sprint(result) || !doArraysMatch([]string{"cord", "gold", "bold"}, dictionary.Neighbours("cold")) {
+			t.Error(
+				"Test number ", i+1, "\n",
+				"Given the inputs:\n",
+				"word = ", input.Word, "\n",
+				"steps = ", input.Steps, "\n",
+				"Expected result to be:\n",
+				"Words = ", input.Result, "\n",
+				"Actual result was:\n",
+				"Words = ", result, "\n",
+			)
+			fmt.Println(" - failed.")
+		} else {
+			fmt.Println(" - passed.")
+		}
+	}
+	fmt.Print("\n")
+}
+
+//Test that the A* search finds the shortest path using the words in the dictionary.
+func TestWordDictionaryAStarAnalyse(t *testing.T) {
+	fmt.Println("Testing dictionary A* method: 'AStarAnalyse'....")
+
+	//Arrange
+	dictionary := LoadWordDictionary("./testInputGraph.txt", "")
+	testInputs := []aStarAnalyseMockInput{
+		{StartWord: "bolt", EndWord: "warm", PathFound: true, ResultPathLength: 7},
+		{StartWord: "cold", EndWord: "card", PathFound: true, ResultPathLength: 3},
+		{StartWord: "cat", EndWord: "dog", PathFound: true, ResultPathLength: 4},
+		{StartWord: "cold", EndWord: "hold", PathFound: true, ResultPathLength: 2},
+		{StartWord: "cat", EndWord: "cold", PathFound: false, ResultPathLength: 0},
+		{StartWord: "bolt", EndWord: "warm", PathFound: true, ResultPathLength: 7},
+	}
+
+	for i, input := range testInputs {
+		fmt.Print("Test ", i+1, " of ", len(testInputs))
+		//Act
+		pathFound, resultPath := dictionary.AStarAnalyse(input.StartWord, input.EndWord)
+
+		//Assert
+		if pathFound != input.PathFound || input.ResultPathLength != len(resultPath) {
+			t.Error(
+				"Test number ", i+1, "\n",
+				"Given the inputs:\n",
+				"start word = ", input.StartWord, "\n",
+				"end word = ", input.EndWord, "\n",
+				"Expected results to be:\n",
+				"Path Found = ", input.PathFound, "\n",
+				"Result Path = ", input.ResultPathLength, "\n",
+				"Actual results were:\n",
+				"Path Found = ", pathFound, "\n",
+				"Result Path = ", resultPath, "\n",
+			)
+			fmt.Println(" - failed.")
+		} else {
+			fmt.Println(" - passed.")
+		}
+	}
+	fmt.Print("\n")
+}
+
+//Test that a word<TAB>count file is loaded with the frequency of each word.
+func TestLoadWordFrequencyDictionary(t *testing.T) {
+	fmt.Println("Testing frequency dictionary loader method: 'LoadWordFrequencyDictionary'....")
+
+	//Arrange
+	words := []string{"test", "pest", "tost", "fail", "hold"}
+	expected := []int{1000, 200, 1, 0, 0}
+	expectedContains := []bool{true, true, true, true, false}
+
+	//Act
+	dictionary := LoadWordFrequencyDictionary("./testInputFrequency.txt")
+
+	for i, word := range words {
+		fmt.Print("Test ", i+1, " of ", len(words))
+		//Assert
+		if dictionary.Frequency(word) != expected[i] || dictionary.Contains(word) != expectedContains[i] {
+			t.Error(
+				"Test number ", i+1, "\n",
+				"Given the word = ", word, "\n",
+				"Expected result to be:\n",
+				"Frequency = ", expected[i], " Contains = ", expectedContains[i], "\n",
+				"Actual result was:\n",
+				"Frequency = ", dictionary.Frequency(word), " Contains = ", dictionary.Contains(word), "\n",
+			)
+			fmt.Println(" - failed.")
+		} else {
+			fmt.Println(" - passed.")
+		}
+	}
+	fmt.Print("\n")
+}
+
+//Test that a word<TAB>count file with a count below 0 stops the program with an error naming the line.
+//The loader calls log.Fatal, so it is run in a copy of the test program started by this test.
+func TestLoadWordFrequencyDictionaryNegativeCount(t *testing.T) {
+	if file := os.Getenv("WORD_FREQUENCY_FILE"); file != "" {
+		LoadWordFrequencyDictionary(file)
+		return
+	}
+	fmt.Println("Testing frequency dictionary loader method: 'LoadWordFrequencyDictionary' (negative count)....")
+
+	//Arrange
+	directory := t.TempDir()
+	testInputs := []frequencyFileMockInput{
+		{Contents: "cold\t10\nwarm\t-5", Error: "line 2: value out of range"},
+		{Contents: "cold\t-1\nwarm\t5", Error: "line 1: value out of range"},
+	}
+
+	for i, input := range testInputs {
+		fmt.Print("Test ", i+1, " of ", len(testInputs))
+		file := filepath.Join(directory, fmt.Sprint("frequency", i, ".txt"))
+		if err := os.WriteFile(file, []byte(input.Contents), 0644); err != nil {
+			t.Fatal(err)
+		}
+		command := exec.Command(os.Args[0], "-test.run=^TestLoadWordFrequencyDictionaryNegativeCount$")
+		command.Env = append(os.Environ(), "WORD_FREQUENCY_FILE="+file)
+
+		//Act
+		output, err := command.CombinedOutput()
+
+		//Assert
+		if err == nil || !strings.Contains(string(output), file+": "+input.Error) {
+			t.Error(
+				"Test number ", i+1, "\n",
+				"Given the inputs:\n",
+				"file contents = ", input.Contents, "\n",
+				"Expected result to be:\n",
+				"Error = ", input.Error, "\n",
+				"Actual result was:\n",
+				"Error = ", err, " ", string(output), "\n",
+			)
+			fmt.Println(" - failed.")
+		} else {
+			fmt.Println(" - passed.")
+		}
+	}
+	fmt.Print("\n")
+}
+
+//Test that words in the blocklist are never added to the dictionary.
+func TestLoadWordDictionaryWithBlocklist(t *testing.T) {
+	fmt.Println("Testing blocklist dictionary loader method: 'LoadWordDictionaryWithBlocklist'....")
+
+	//Arrange
+	testInputs := []aStarAnalyseMockInput{
+		{StartWord: "cold", EndWord: "warm", PathFound: true, ResultPathLength: 5},
+		{StartWord: "cold", EndWord: "care", PathFound: true, ResultPathLength: 4},
+		{StartWord: "ward", EndWord: "care", PathFound: true, ResultPathLength: 5},
+		{StartWord: "cat", EndWord: "cold", PathFound: false, ResultPathLength: 0},
+	}
+
+	//Act
+	dictionary := LoadWordDictionaryWithBlocklist("./testInputGraph.txt", "", "./testInputBlocklist.txt")
+
+	for i, input := range testInputs {
+		fmt.Print("Test ", i+1, " of ", len(testInputs))
+		pathFound, resultPath := dictionary.AStarAnalyse(input.StartWord, input.EndWord)
+
+		//Assert
+		if pathFound != input.PathFound || input.ResultPathLength != len(resultPath) || dictionary.Contains("card") || dictionary.Contains("worm") {
+			t.Error(
+				"Test number ", i+1, "\n",
+				"Given the inputs:\n",
+				"start word = ", input.StartWord, "\n",
+				"end word = ", input.EndWord, "\n",
+				"Expected results to be:\n",
+				"Path Found = ", input.PathFound, "\n",
+				"Result Path = ", input.ResultPathLength, "\n",
+				"Actual results were:\n",
+				"Path Found = ", pathFound, "\n",
+				"Result Path = ", resultPath, "\n",
+			)
+			fmt.Println(" - failed.")
+		} else {
+			fmt.Println(" - passed.")
+		}
+	}
+	fmt.Print("\n")
+}
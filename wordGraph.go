@@ -0,0 +1,213 @@
+package wordPathAnalyser
+
+import (
+	"runtime"
+	"sort"
+	"sync"
+)
+
+//Largest number of distinct ladders counted between two words.
+const maxLadderCount = 1 << 30
+
+//wordGraph holds the one letter change relationship between every word of a single length.
+type wordGraph struct {
+	//Length of every word in the graph.
+	WordLength int
+	//The words in the graph, the index of a word is used as its ID.
+	Words []string
+	//For each word ID the IDs of the words that are one letter change away.
+	Edges [][]int
+	//ID of each word, only set for graphs held by a dictionary (see indexWords).
+	ids map[string]int
+}
+
+//Create a word graph for every word length found in the list of words, the result is sorted by word length.
+//Empty and duplicate words are ignored.
+func newWordGraphs(words []string) []*wordGraph {
+	//Map of word length to the graph holding words of that length.
+	graphsByLength := make(map[int]*wordGraph)
+	//Set of words already added to a graph.
+	seen := make(map[string]bool, len(words))
+
+	for _, word := range words {
+		if word == "" || seen[word] {
+			continue
+		}
+		seen[word] = true
+
+		graph, ok := graphsByLength[len(word)]
+		if !ok {
+			graph = &wordGraph{WordLength: len(word)}
+			graphsByLength[len(word)] = graph
+		}
+		graph.Words = append(graph.Words, word)
+	}
+
+	graphs := make([]*wordGraph, 0, len(graphsByLength))
+	for _, graph := range graphsByLength {
+		graphs = append(graphs, graph)
+	}
+	sort.Slice(graphs, func(i, j int) bool { return graphs[i].WordLength < graphs[j].WordLength })
+
+	//The edges of every graph are built as one batch of IDs, so words of every length are split across the same GOMAXPROCS goroutines.
+	builders := make([]func(id int), len(graphs))
+	//Batch ID of the first word in each graph, the last entry is the number of words in every graph.
+	firstIDs := make([]int, len(graphs)+1)
+	for i, graph := range graphs {
+		builders[i] = graph.edgeBuilder()
+		firstIDs[i+1] = firstIDs[i] + len(graph.Words)
+	}
+	forEachIDConcurrently(firstIDs[len(graphs)], func(id int) {
+		//The graph holding the word is the last graph whose first ID is not after the ID.
+		i := sort.SearchInts(firstIDs, id+1) - 1
+		builders[i](id - firstIDs[i])
+	})
+
+	return graphs
+}
+
+//Set up calculating the edges of the graph, the returned function sets the edges of a single word ID either from the generated
+//neighbours or by scanning every word with generateNodeChildren. Each word ID can be set on a different goroutine.
+func (g *wordGraph) edgeBuilder() func(id int) {
+	//Word nodes for every word in the graph (these are only read from so can be shared between goroutines).
+	nodes := make([]*aStarWordNode, len(g.Words))
+	//Maps used to convert a child node or generated word back into its word ID.
+	ids := make(map[*aStarWordNode]int, len(g.Words))
+	wordIDs := make(map[string]int, len(g.Words))
+	for i, word := range g.Words {
+		node := newAStarWordNode(word)
+		nodes[i] = &node
+		ids[&node] = i
+		wordIDs[word] = i
+	}
+
+	g.Edges = make([][]int, len(g.Words))
+	//Neighbour generator used when it is quicker than scanning every word (the neighbours are in ID order).
+	generate := candidateNeighbours(NeighbourAuto, nodes)
+	return func(id int) {
+		if generate != nil {
+			neighbours := generate(g.Words[id])
+			edges := make([]int, len(neighbours))
+			for i, word := range neighbours {
+				edges[i] = wordIDs[word]
+			}
+			g.Edges[id] = edges
+			return
+		}
+		//The scan is not split into blocks as every goroutine is already busy with its own words.
+		children, _ := generateNodeChildren(nodes[id], nodes, nil)
+		edges := make([]int, len(children))
+		for i, child := range children {
+			edges[i] = ids[child]
+		}
+		g.Edges[id] = edges
+	}
+}
+
+//Set up the map used by wordID.
+func (g *wordGraph) indexWords() {
+	g.ids = make(map[string]int, len(g.Words))
+	for id, word := range g.Words {
+		g.ids[word] = id
+	}
+}
+
+//Find the words one letter change away from the word with the given ID (in ID order).
+func (g *wordGraph) neighbourWords(id int) []string {
+	words := make([]string, len(g.Edges[id]))
+	for i, edge := range g.Edges[id] {
+		words[i] = g.Words[edge]
+	}
+	return words
+}
+
+//Find the ID of a word in a graph that has been indexed (-1 if the word is not in the graph).
+func (g *wordGraph) wordID(word string) int {
+	if id, found := g.ids[word]; found {
+		return id
+	}
+	return -1
+}
+
+//Calculate the number of steps from the source word to every other word in the graph using a breadth first search.
+//Words that cannot be reached from the source have a distance of -1.
+func (g *wordGraph) distancesFrom(source int) []int {
+	distances := make([]int, len(g.Words))
+	for i := range distances {
+		distances[i] = -1
+	}
+	distances[source] = 0
+
+	//Queue of word IDs still to be expanded (in order of distance).
+	queue := []int{source}
+	for len(queue) != 0 {
+		current := queue[0]
+		queue = queue[1:]
+		for _, next := range g.Edges[current] {
+			if distances[next] == -1 {
+				distances[next] = distances[current] + 1
+				queue = append(queue, next)
+			}
+		}
+	}
+
+	return distances
+}
+
+//Calculate the number of steps from the source word to every other word and the number of distinct shortest ladders to each word.
+//Words that cannot be reached have a distance of -1 and a count of 0, counts stop increasing at maxLadderCount.
+func (g *wordGraph) ladderCountsFrom(source int) (distances, counts []int) {
+	distances = g.distancesFrom(source)
+	counts = make([]int, len(g.Words))
+	counts[source] = 1
+
+	//Word IDs in order of distance, so each word's count is final before it is added to its neighbours.
+	order := make([]int, 0, len(g.Words))
+	for id, distance := range distances {
+		if distance != -1 {
+			order = append(order, id)
+		}
+	}
+	sort.SliceStable(order, func(i, j int) bool { return distances[order[i]] < distances[order[j]] })
+
+	for _, current := range order {
+		for _, next := range g.Edges[current] {
+			if distances[next] == distances[current]+1 {
+				counts[next] += counts[current]
+				if counts[next] > maxLadderCount {
+					counts[next] = maxLadderCount
+				}
+			}
+		}
+	}
+
+	return
+}
+
+//Call fn for every ID from 0 to count-1 using GOMAXPROCS goroutines and wait for them all to finish.
+func forEachIDConcurrently(count int, fn func(id int)) {
+	//Number of goroutines to use, there is no point starting more goroutines than there are IDs.
+	workers := runtime.GOMAXPROCS(0)
+	if workers > count {
+		workers = count
+	}
+
+	var waitGroup sync.WaitGroup
+	waitGroup.Add(workers)
+	ids := make(chan int, workers)
+
+	for i := 0; i < workers; i++ {
+		go func() {
+			defer waitGroup.Done()
+			for id := range ids {
+				fn(id)
+			}
+		}()
+	}
+
+	for id := 0; id < count; id++ {
+		ids <- id
+	}
+	close(ids)
+	waitGroup.Wait()
+}
@@ -0,0 +1,173 @@
+package wordPathAnalyser
+
+import (
+	"bufio"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strings"
+)
+
+//WordGraphExportOptions is used to choose which part of the word graph is exported.
+type WordGraphExportOptions struct {
+	//Word at the centre of the exported neighbourhood (**If the whole graph is to be exported enter ""**)
+	CentreWord string
+	//Maximum number of steps a word can be from CentreWord to be exported.
+	Steps int
+	//A ladder (such as the path returned by AStarAnalyseFile) to highlight, its words are always exported.
+	Ladder []string
+}
+
+//A part of the word graph chosen for export.
+type wordSubgraph struct {
+	//Words exported (grouped by word length then in file order).
+	Words []string
+	//Edges exported, each edge is only listed once.
+	Edges [][2]string
+	//Words and edges that are part of the highlighted ladder.
+	LadderWords map[string]bool
+	LadderEdges map[[2]string]bool
+}
+
+//ExportWordGraphDOTFile writes the one letter change graph of the words in a file in Graphviz DOT format.
+//INPUTS: filelocation, delimiter (strings) (**If delimiter is not to be used enter ""**), export options (WordGraphExportOptions), output (io.Writer)
+//OUTPUT: error writing to the output (error)
+func ExportWordGraphDOTFile(fL, dL string, o WordGraphExportOptions, w io.Writer) error {
+	return writeDOT(selectSubgraph(newWordGraphs(readWordList(fL, dL)), o), w)
+}
+
+//ExportWordGraphGraphMLFile writes the one letter change graph of the words in a file in GraphML format.
+//INPUTS: filelocation, delimiter (strings) (**If delimiter is not to be used enter ""**), export options (WordGraphExportOptions), output (io.Writer)
+//OUTPUT: error writing to the output (error)
+func ExportWordGraphGraphMLFile(fL, dL string, o WordGraphExportOptions, w io.Writer) error {
+	return writeGraphML(selectSubgraph(newWordGraphs(readWordList(fL, dL)), o), w)
+}
+
+//Choose the words and edges to export from the word graphs.
+func selectSubgraph(graphs []*wordGraph, o WordGraphExportOptions) wordSubgraph {
+	subgraph := wordSubgraph{
+		Words:       []string{},
+		Edges:       [][2]string{},
+		LadderWords: make(map[string]bool, len(o.Ladder)),
+		LadderEdges: make(map[[2]string]bool, len(o.Ladder)),
+	}
+	for i, word := range o.Ladder {
+		subgraph.LadderWords[word] = true
+		if i > 0 {
+			subgraph.LadderEdges[edgeKey(o.Ladder[i-1], word)] = true
+		}
+	}
+
+	for _, graph := range graphs {
+		//Flags of which word IDs are exported from this graph.
+		included := make([]bool, len(graph.Words))
+		for id, word := range graph.Words {
+			included[id] = o.CentreWord == "" || subgraph.LadderWords[word]
+		}
+		if o.CentreWord != "" && len(o.CentreWord) == graph.WordLength {
+			if centre := indexOf(graph.Words, o.CentreWord); centre != -1 {
+				for id, distance := range graph.distancesFrom(centre) {
+					if distance != -1 && distance <= o.Steps {
+						included[id] = true
+					}
+				}
+			}
+		}
+
+		for id, word := range graph.Words {
+			if !included[id] {
+				continue
+			}
+			subgraph.Words = append(subgraph.Words, word)
+			for _, next := range graph.Edges[id] {
+				//Only add the edge from the lower ID so that it is listed once.
+				if next > id && included[next] {
+					subgraph.Edges = append(subgraph.Edges, [2]string{word, graph.Words[next]})
+				}
+			}
+		}
+	}
+
+	return subgraph
+}
+
+//Write the subgraph as an undirected Graphviz DOT graph, the ladder is drawn in bold red.
+func writeDOT(s wordSubgraph, w io.Writer) error {
+	writer := bufio.NewWriter(w)
+
+	fmt.Fprintln(writer, "graph words {")
+	for _, word := range s.Words {
+		if s.LadderWords[word] {
+			fmt.Fprintf(writer, "\t%s [color=red, style=bold];\n", dotID(word))
+		} else {
+			fmt.Fprintf(writer, "\t%s;\n", dotID(word))
+		}
+	}
+	for _, edge := range s.Edges {
+		if s.LadderEdges[edgeKey(edge[0], edge[1])] {
+			fmt.Fprintf(writer, "\t%s -- %s [color=red, penwidth=2];\n", dotID(edge[0]), dotID(edge[1]))
+		} else {
+			fmt.Fprintf(writer, "\t%s -- %s;\n", dotID(edge[0]), dotID(edge[1]))
+		}
+	}
+	fmt.Fprintln(writer, "}")
+
+	return writer.Flush()
+}
+
+//Write the subgraph as an undirected GraphML graph, nodes and edges of the ladder have the "ladder" attribute set to true.
+func writeGraphML(s wordSubgraph, w io.Writer) error {
+	writer := bufio.NewWriter(w)
+
+	fmt.Fprintln(writer, xml.Header+`<graphml xmlns="http://graphml.graphdrawing.org/xmlns">`)
+	fmt.Fprintln(writer, `  <key id="ladder" for="all" attr.name="ladder" attr.type="boolean"><default>false</default></key>`)
+	fmt.Fprintln(writer, `  <graph id="words" edgedefault="undirected">`)
+	for _, word := range s.Words {
+		if s.LadderWords[word] {
+			fmt.Fprintf(writer, "    <node id=\"%s\"><data key=\"ladder\">true</data></node>\n", xmlText(word))
+		} else {
+			fmt.Fprintf(writer, "    <node id=\"%s\"/>\n", xmlText(word))
+		}
+	}
+	for _, edge := range s.Edges {
+		if s.LadderEdges[edgeKey(edge[0], edge[1])] {
+			fmt.Fprintf(writer, "    <edge source=\"%s\" target=\"%s\"><data key=\"ladder\">true</data></edge>\n", xmlText(edge[0]), xmlText(edge[1]))
+		} else {
+			fmt.Fprintf(writer, "    <edge source=\"%s\" target=\"%s\"/>\n", xmlText(edge[0]), xmlText(edge[1]))
+		}
+	}
+	fmt.Fprintln(writer, "  </graph>")
+	fmt.Fprintln(writer, "</graphml>")
+
+	return writer.Flush()
+}
+
+//Create the key used to look up an undirected edge (the words are stored in alphabetical order).
+func edgeKey(a, b string) [2]string {
+	if b < a {
+		return [2]string{b, a}
+	}
+	return [2]string{a, b}
+}
+
+//Quote a word so it can be used as a DOT ID.
+func dotID(word string) string {
+	return `"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(word) + `"`
+}
+
+//Escape a word so it can be used in an XML attribute.
+func xmlText(word string) string {
+	var builder strings.Builder
+	xml.EscapeText(&builder, []byte(word))
+	return builder.String()
+}
+
+//Find the position of a word in an array (-1 if it is not found).
+func indexOf(words []string, word string) int {
+	for i, w := range words {
+		if w == word {
+			return i
+		}
+	}
+	return -1
+}
@@ -0,0 +1,8 @@
+package wordPathAnalyser
+
+//Structs used to hold the mocked input.
+type wordGraphExportMockInput struct {
+	FileLocation, Delimiter string
+	Options                 WordGraphExportOptions
+	Result                  string
+}
@@ -0,0 +1,129 @@
+package wordPathAnalyser
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+//Test that the word graph (or part of it) is written in DOT format.
+func TestExportWordGraphDOTFile(t *testing.T) {
+	fmt.Println("Testing DOT export method: 'ExportWordGraphDOTFile'....")
+
+	//Arrange
+	testInputs := []wordGraphExportMockInput{
+		{FileLocation: "./testInputGraph.txt",
+			Delimiter: "",
+			Options:   WordGraphExportOptions{CentreWord: "cold", Steps: 1, Ladder: []string{"cord", "cold", "bold"}},
+			Result: "graph words {\n" +
+				"\t\"cold\" [color=red, style=bold];\n" +
+				"\t\"cord\" [color=red, style=bold];\n" +
+				"\t\"gold\";\n" +
+				"\t\"bold\" [color=red, style=bold];\n" +
+				"\t\"cold\" -- \"cord\" [color=red, penwidth=2];\n" +
+				"\t\"cold\" -- \"gold\";\n" +
+				"\t\"cold\" -- \"bold\" [color=red, penwidth=2];\n" +
+				"\t\"gold\" -- \"bold\";\n" +
+				"}\n"},
+		{FileLocation: "./testInputGraph.txt",
+			Delimiter: "",
+			Options:   WordGraphExportOptions{CentreWord: "dog", Steps: 0},
+			Result:    "graph words {\n\t\"dog\";\n}\n"},
+		{FileLocation: "./testInput.txt",
+			Delimiter: "",
+			Options:   WordGraphExportOptions{},
+			Result: "graph words {\n" +
+				"\t\"test\";\n\t\"pest\";\n\t\"post\";\n\t\"most\";\n\t\"fail\";\n" +
+				"\t\"test\" -- \"pest\";\n\t\"pest\" -- \"post\";\n\t\"post\" -- \"most\";\n" +
+				"}\n"},
+	}
+
+	for i, input := range testInputs {
+		fmt.Print("Test ", i+1, " of ", len(testInputs))
+		//Act
+		var output strings.Builder
+		err := ExportWordGraphDOTFile(input.FileLocation, input.Delimiter, input.Options, &output)
+
+		//Assert
+		if err != nil || input.Result != output.String() {
+			t.Error(
+				"Test number ", i+1, "\n",
+				"Given the inputs:\n",
+				"file location = ", input.FileLocation, "\n",
+				"options = ", input.Options, "\n",
+				"Expected result to be:\n",
+				input.Result, "\n",
+				"Actual result was:\n",
+				output.String(), "\n",
+				"Error = ", err, "\n",
+			)
+			fmt.Println(" - failed.")
+		} else {
+			fmt.Println(" - passed.")
+		}
+	}
+	fmt.Print("\n")
+}
+
+//Test that the word graph (or part of it) is written in GraphML format.
+func TestExportWordGraphGraphMLFile(t *testing.T) {
+	fmt.Println("Testing GraphML export method: 'ExportWordGraphGraphMLFile'....")
+
+	//Arrange
+	header := "<?xml version=\"1.0\" encoding=\"UTF-8\"?>\n" +
+		"<graphml xmlns=\"http://graphml.graphdrawing.org/xmlns\">\n" +
+		"  <key id=\"ladder\" for=\"all\" attr.name=\"ladder\" attr.type=\"boolean\"><default>false</default></key>\n" +
+		"  <graph id=\"words\" edgedefault=\"undirected\">\n"
+	footer := "  </graph>\n</graphml>\n"
+	testInputs := []wordGraphExportMockInput{
+		{FileLocation: "./testInputDelimited.txt",
+			Delimiter: ",",
+			Options:   WordGraphExportOptions{Ladder: []string{"pest", "post"}},
+			Result: header +
+				"    <node id=\"test\"/>\n" +
+				"    <node id=\"pest\"><data key=\"ladder\">true</data></node>\n" +
+				"    <node id=\"post\"><data key=\"ladder\">true</data></node>\n" +
+				"    <node id=\"most\"/>\n" +
+				"    <node id=\"fail\"/>\n" +
+				"    <edge source=\"test\" target=\"pest\"/>\n" +
+				"    <edge source=\"pest\" target=\"post\"><data key=\"ladder\">true</data></edge>\n" +
+				"    <edge source=\"post\" target=\"most\"/>\n" +
+				footer},
+		{FileLocation: "./testInputGraph.txt",
+			Delimiter: "",
+			Options:   WordGraphExportOptions{CentreWord: "cog", Steps: 1},
+			Result: header +
+				"    <node id=\"cot\"/>\n" +
+				"    <node id=\"dog\"/>\n" +
+				"    <node id=\"cog\"/>\n" +
+				"    <edge source=\"cot\" target=\"cog\"/>\n" +
+				"    <edge source=\"dog\" target=\"cog\"/>\n" +
+				footer},
+	}
+
+	for i, input := range testInputs {
+		fmt.Print("Test ", i+1, " of ", len(testInputs))
+		//Act
+		var output strings.Builder
+		err := ExportWordGraphGraphMLFile(input.FileLocation, input.Delimiter, input.Options, &output)
+
+		//Assert
+		if err != nil || input.Result != output.String() {
+			t.Error(
+				"Test number ", i+1, "\n",
+				"Given the inputs:\n",
+				"file location = ", input.FileLocation, "\n",
+				"options = ", input.Options, "\n",
+				"Expected result to be:\n",
+				input.Result, "\n",
+				"Actual result was:\n",
+				output.String(), "\n",
+				"Error = ", err, "\n",
+			)
+			fmt.Println(" - failed.")
+		} else {
+			fmt.Println(" - passed.")
+		}
+	}
+	fmt.Print("\n")
+}
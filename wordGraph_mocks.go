@@ -0,0 +1,18 @@
+package wordPathAnalyser
+
+//Structs used to hold the mocked input.
+type wordGraphNewWordGraphsMockInput struct {
+	Words        []string
+	ResultWords  [][]string
+	ResultLength []int
+}
+type wordGraphDistancesFromMockInput struct {
+	Words           []string
+	SourceWord      string
+	ResultDistances []int
+}
+type wordGraphLadderCountsFromMockInput struct {
+	Words                         []string
+	SourceWord                    string
+	ResultDistances, ResultCounts []int
+}
@@ -0,0 +1,138 @@
+package wordPathAnalyser
+
+import (
+	"fmt"
+	"testing"
+)
+
+//Test that the words are split into a graph per word length.
+func TestNewWordGraphs(t *testing.T) {
+	fmt.Println("Testing word graph creation method: 'newWordGraphs'....")
+
+	//Arrange
+	testInputs := []wordGraphNewWordGraphsMockInput{
+		{Words: []string{"test", "pest", "cat", "best", "cot"},
+			ResultWords:  [][]string{{"cat", "cot"}, {"test", "pest", "best"}},
+			ResultLength: []int{3, 4}},
+		{Words: []string{"test", "", "test", "pest"},
+			ResultWords:  [][]string{{"test", "pest"}},
+			ResultLength: []int{4}},
+		{Words: []string{},
+			ResultWords:  [][]string{},
+			ResultLength: []int{}},
+	}
+
+	for i, input := range testInputs {
+		fmt.Print("Test ", i+1, " of ", len(testInputs))
+		//Act
+		result := newWordGraphs(input.Words)
+
+		//Assert
+		passed := len(result) == len(input.ResultWords)
+		for j := 0; passed && j < len(result); j++ {
+			passed = result[j].WordLength == input.ResultLength[j] && doArraysMatch(input.ResultWords[j], result[j].Words)
+		}
+		if !passed {
+			t.Error(
+				"Test number ", i+1, "\n",
+				"Given the inputs:\n",
+				"Words = ", input.Words, "\n",
+				"Expected result to be:\n",
+				"Graph Words = ", input.ResultWords, "\n",
+				"Actual result was:\n",
+				"Graphs = ", result, "\n",
+			)
+			fmt.Println(" - failed.")
+		} else {
+			fmt.Println(" - passed.")
+		}
+	}
+	fmt.Print("\n")
+}
+
+//Test that the breadth first search returns the number of steps to every word in the graph.
+func TestWordGraphDistancesFrom(t *testing.T) {
+	fmt.Println("Testing word graph search method: 'distancesFrom'....")
+
+	//Arrange
+	testInputs := []wordGraphDistancesFromMockInput{
+		{Words: []string{"test", "best", "beat", "brat", "brag"},
+			SourceWord:      "test",
+			ResultDistances: []int{0, 1, 2, 3, 4}},
+		{Words: []string{"test", "best", "beat", "brat", "brag"},
+			SourceWord:      "beat",
+			ResultDistances: []int{2, 1, 0, 1, 2}},
+		{Words: []string{"test", "pest", "fail"},
+			SourceWord:      "test",
+			ResultDistances: []int{0, 1, -1}},
+	}
+
+	for i, input := range testInputs {
+		fmt.Print("Test ", i+1, " of ", len(testInputs))
+		//Act
+		graph := newWordGraphs(input.Words)[0]
+		result := graph.distancesFrom(indexOf(graph.Words, input.SourceWord))
+
+		//Assert
+		if fmt.Sprint(input.ResultDistances) != fmt.Sprint(result) {
+			t.Error(
+				"Test number ", i+1, "\n",
+				"Given the inputs:\n",
+				"Words = ", input.Words, "\n",
+				"Source word = ", input.SourceWord, "\n",
+				"Expected result to be:\n",
+				"Distances = ", input.ResultDistances, "\n",
+				"Actual result was:\n",
+				"Distances = ", result, "\n",
+			)
+			fmt.Println(" - failed.")
+		} else {
+			fmt.Println(" - passed.")
+		}
+	}
+	fmt.Print("\n")
+}
+
+//Test that the number of distinct shortest ladders to each word is counted.
+func TestWordGraphLadderCountsFrom(t *testing.T) {
+	fmt.Println("Testing word graph ladder count method: 'ladderCountsFrom'....")
+
+	//Arrange
+	testInputs := []wordGraphLadderCountsFromMockInput{
+		{Words: []string{"cat", "cot", "dot", "dog", "cog"},
+			SourceWord:      "cat",
+			ResultDistances: []int{0, 1, 2, 3, 2},
+			ResultCounts:    []int{1, 1, 1, 2, 1}},
+		{Words: []string{"test", "pest", "fail"},
+			SourceWord:      "fail",
+			ResultDistances: []int{-1, -1, 0},
+			ResultCounts:    []int{0, 0, 1}},
+	}
+
+	for i, input := range testInputs {
+		fmt.Print("Test ", i+1, " of ", len(testInputs))
+		//Act
+		graph := newWordGraphs(input.Words)[0]
+		distances, counts := graph.ladderCountsFrom(indexOf(graph.Words, input.SourceWord))
+
+		//Assert
+		if fmt.Sprint(input.ResultDistances) != fmt.Sprint(distances) || fmt.Sprint(input.ResultCounts) != fmt.Sprint(counts) {
+			t.Error(
+				"Test number ", i+1, "\n",
+				"Given the inputs:\n",
+				"Words = ", input.Words, "\n",
+				"Source word = ", input.SourceWord, "\n",
+				"Expected result to be:\n",
+				"Distances = ", input.ResultDistances, "\n",
+				"Counts = ", input.ResultCounts, "\n",
+				"Actual result was:\n",
+				"Distances = ", distances, "\n",
+				"Counts = ", counts, "\n",
+			)
+			fmt.Println(" - failed.")
+		} else {
+			fmt.Println(" - passed.")
+		}
+	}
+	fmt.Print("\n")
+}
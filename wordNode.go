@@ -1,5 +1,6 @@
 package wordPathAnalyser
 
+//aStarWordNode is the state of a word in one search, dictionaries only hold words so a node is never shared between searches.
 type aStarWordNode struct {
 	//fScore - Total estimated number of steps to goal.
 	//gscore - Total cost of current path.
@@ -7,13 +8,19 @@ type aStarWordNode struct {
 	FScore, GScore, HScore int
 	ParentNode             *aStarWordNode
 	Word                   string
+	//The word packed for fast comparisons, only used when isPacked is true (see packWord).
+	packed   uint64
+	isPacked bool
 }
 
 func newAStarWordNode(word string) aStarWordNode {
+	packed, isPacked := packWord(word)
 	return aStarWordNode{
-		FScore: 0,
-		GScore: 0,
-		HScore: 0,
-		Word:   word,
+		FScore:   0,
+		GScore:   0,
+		HScore:   0,
+		Word:     word,
+		packed:   packed,
+		isPacked: isPacked,
 	}
 }